@@ -0,0 +1,109 @@
+package inflect
+
+import "strings"
+
+// adjectives with irregular forms: positive, comparative, superlative.
+var irregularAdjectives = [][3]string{
+	{"bad", "worse", "worst"},
+	{"far", "farther", "farthest"},
+	{"fun", "more fun", "most fun"},
+	{"good", "better", "best"},
+	{"ill", "worse", "worst"},
+	{"little", "less", "least"},
+	{"many", "more", "most"},
+	{"much", "more", "most"},
+	{"real", "more real", "most real"},
+	{"right", "more right", "most right"},
+	{"well", "better", "best"},
+	{"wrong", "more wrong", "most wrong"},
+}
+
+func addDefaultAdjectives(rs *Ruleset) {
+	for _, a := range irregularAdjectives {
+		rs.AddIrregularAdjective(a[0], a[1], a[2])
+	}
+}
+
+// add a rule for comparative adjectives: "clever" -> "cleverer"
+func (rs *Ruleset) AddComparative(suffix, replacement string) {
+	rs.comparatives = append(rs.comparatives, Rule{match: suffix, sub: replacement})
+}
+
+// add a rule for superlative adjectives: "clever" -> "cleverest"
+func (rs *Ruleset) AddSuperlative(suffix, replacement string) {
+	rs.superlatives = append(rs.superlatives, Rule{match: suffix, sub: replacement})
+}
+
+// Add an adjective whose forms don't follow the usual rules: "good", "better", "best".
+// like irregular verbs, irregular adjectives match the whole word.
+func (rs *Ruleset) AddIrregularAdjective(positive, comparative, superlative string) {
+	rs.comparatives = append(rs.comparatives, Rule{match: positive, sub: comparative, exact: true})
+	rs.superlatives = append(rs.superlatives, Rule{match: positive, sub: superlative, exact: true})
+}
+
+// Comparative: "large" -> "larger", "big" -> "bigger", "busy" -> "busier", "expensive" -> "more expensive", "good" -> "better".
+// adjectives of three or more syllables, and most of two, use "more".
+func (rs *Ruleset) Comparative(adj string) string {
+	return rs.compare(rs.comparatives, adj, "er", "more ")
+}
+
+// Superlative: "large" -> "largest", "big" -> "biggest", "busy" -> "busiest", "expensive" -> "most expensive", "good" -> "best".
+func (rs *Ruleset) Superlative(adj string) string {
+	return rs.compare(rs.superlatives, adj, "est", "most ")
+}
+
+func (rs *Ruleset) compare(rules []Rule, adj, suffix, adverb string) (ret string) {
+	if lower := rs.toLower(adj); len(lower) == 0 {
+		ret = adj
+	} else {
+		var sub string
+		if rule, ok := findRule(rules, lower); ok {
+			sub = strings.TrimSuffix(lower, rule.match) + rule.sub
+		} else if n := adjectiveSyllables(lower); strings.ContainsRune(lower, ' ') || n > 2 ||
+			(n == 2 && !endsInConsonantY(lower) && !strings.HasSuffix(lower, "le") && !strings.HasSuffix(lower, "ow")) {
+			sub = adverb + lower
+		} else if strings.HasSuffix(lower, "e") {
+			sub = lower + suffix[1:] // "large" -> "larger"
+		} else if endsInConsonantY(lower) {
+			sub = lower[:len(lower)-1] + "i" + suffix // "busy" -> "busier"
+		} else if doublesFinal(lower) {
+			sub = lower + lower[len(lower)-1:] + suffix // "big" -> "bigger"
+		} else {
+			sub = lower + suffix
+		}
+		ret = rs.matchCase(adj, sub)
+	}
+	return
+}
+
+// a guess at syllables which understands silent e, and a final y: "large" has one, "happy" two.
+func adjectiveSyllables(word string) (ret int) {
+	ret = syllables(word)
+	if n := len(word); ret > 1 && word[n-1] == 'e' && !(n > 2 && word[n-2] == 'l' && !isVowel(word[n-3])) {
+		ret-- // silent e, except for "-ble", "-tle", and the like.
+	}
+	if endsInConsonantY(word) || ret == 0 {
+		ret++
+	}
+	return
+}
+
+func AddComparative(suffix, replacement string) {
+	Rules.AddComparative(suffix, replacement)
+}
+
+func AddSuperlative(suffix, replacement string) {
+	Rules.AddSuperlative(suffix, replacement)
+}
+
+func AddIrregularAdjective(positive, comparative, superlative string) {
+	Rules.AddIrregularAdjective(positive, comparative, superlative)
+}
+
+func Comparative(adj string) string {
+	return Rules.Comparative(adj)
+}
+
+func Superlative(adj string) string {
+	return Rules.Superlative(adj)
+}
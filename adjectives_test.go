@@ -0,0 +1,60 @@
+package inflect
+
+import (
+	"testing"
+)
+
+// positive, comparative, superlative
+var AdjectiveForms = [][3]string{
+	{"large", "larger", "largest"},
+	{"free", "freer", "freest"},
+	{"big", "bigger", "biggest"},
+	{"hot", "hotter", "hottest"},
+	{"new", "newer", "newest"},
+	{"gray", "grayer", "grayest"},
+	{"fast", "faster", "fastest"},
+	{"red", "redder", "reddest"},
+	{"dry", "drier", "driest"},
+	{"busy", "busier", "busiest"},
+	{"happy", "happier", "happiest"},
+	{"simple", "simpler", "simplest"},
+	{"narrow", "narrower", "narrowest"},
+	{"quiet", "quieter", "quietest"},
+	{"modern", "more modern", "most modern"},
+	{"expensive", "more expensive", "most expensive"},
+	{"beautiful", "more beautiful", "most beautiful"},
+	{"tired", "more tired", "most tired"},
+	{"good", "better", "best"},
+	{"bad", "worse", "worst"},
+	{"far", "farther", "farthest"},
+	{"fun", "more fun", "most fun"},
+	{"Large", "Larger", "Largest"},
+	{"Expensive", "More expensive", "Most expensive"},
+	{"GOOD", "BETTER", "BEST"},
+	{"", "", ""},
+}
+
+func TestAdjectives(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for _, a := range AdjectiveForms {
+		if want, got := a[1], rs.Comparative(a[0]); got != want {
+			t.Error("want", want, "got", got)
+		}
+		if want, got := a[2], rs.Superlative(a[0]); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+func TestAddIrregularAdjective(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	rs.AddIrregularAdjective("far", "further", "furthest")
+	if want, got := "further", rs.Comparative("far"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	rs.AddComparative("clever", "cleverer")
+	rs.AddSuperlative("clever", "cleverest")
+	if want, got := "cleverest", rs.Superlative("clever"); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
@@ -0,0 +1,101 @@
+package inflect
+
+import "strings"
+
+func addDefaultAgreements(rs *Ruleset) {
+	for _, pair := range [][2]string{
+		{"is", "are"},
+		{"was", "were"},
+		{"has", "have"},
+		{"does", "do"},
+		{"isn't", "aren't"},
+		{"wasn't", "weren't"},
+		{"hasn't", "haven't"},
+		{"doesn't", "don't"},
+		{"this", "these"},
+		{"that", "those"},
+		{"it", "they"},
+		{"its", "their"},
+		{"itself", "themselves"},
+	} {
+		rs.AddAgreement(pair[0], pair[1])
+	}
+	rs.AddMassNoun("data")
+	rs.AddMassNoun("metadata")
+}
+
+// AddAgreement: a word which changes to agree with its subject: "is" -> "are".
+func (rs *Ruleset) AddAgreement(singular, plural string) {
+	rs.agreements = append(rs.agreements, Rule{match: singular, sub: plural, exact: true})
+}
+
+// AddMassNoun: a plural noun that takes singular words: "the data is loaded".
+func (rs *Ruleset) AddMassNoun(word string) {
+	rs.massNouns = append(rs.massNouns, Rule{match: word, exact: true})
+}
+
+// Agree: the form of word that agrees with a count.
+// 1 -> "is", "this", "deletes"; 0, 3, or 1.5 -> "are", "these", "delete".
+// word can be either form of an agreement; see AddAgreement.
+// any other word is treated as a verb in its base form: "delete" -> "deletes".
+// phrases change their first word: "was deleted" -> "were deleted".
+func (rs *Ruleset) Agree(n float64, word string) string {
+	return rs.agree(n != 1 && n != -1, word)
+}
+
+// AgreeNoun: the form of word that agrees with noun.
+// ("users", "is") -> "are"; ("user", "is") -> "is".
+// uncountable and mass nouns agree with singular words: ("sheep", "was") -> "was", ("data", "is") -> "is".
+func (rs *Ruleset) AgreeNoun(noun, word string) string {
+	plural := !rs.isUncountable(noun) && !rs.isMassNoun(noun) && rs.IsPlural(noun) == NumberYes
+	return rs.agree(plural, word)
+}
+
+func (rs *Ruleset) agree(plural bool, word string) (ret string) {
+	head, tail := word, ""
+	if i := strings.IndexByte(word, ' '); i > 0 {
+		head, tail = word[:i], word[i:]
+	}
+	lower := rs.toLower(head)
+	for i := len(rs.agreements) - 1; i >= 0; i-- {
+		if rule := rs.agreements[i]; lower == rule.match || lower == rule.sub {
+			sub := rule.match
+			if plural {
+				sub = rule.sub
+			}
+			return rs.matchCase(head, sub) + tail
+		}
+	}
+	if plural {
+		ret = word
+	} else {
+		ret = rs.ThirdPerson(word)
+	}
+	return
+}
+
+// checks the whole word, then the last one: "sensor data".
+func (rs *Ruleset) isMassNoun(word string) bool {
+	_, exact := find(rs.massNouns, rs.toLower(word))
+	if !exact {
+		_, last := lastWord(word)
+		_, exact = find(rs.massNouns, rs.toLower(last))
+	}
+	return exact
+}
+
+func AddAgreement(singular, plural string) {
+	Rules.AddAgreement(singular, plural)
+}
+
+func AddMassNoun(word string) {
+	Rules.AddMassNoun(word)
+}
+
+func Agree(n float64, word string) string {
+	return Rules.Agree(n, word)
+}
+
+func AgreeNoun(noun, word string) string {
+	return Rules.AgreeNoun(noun, word)
+}
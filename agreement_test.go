@@ -0,0 +1,69 @@
+package inflect
+
+import (
+	"testing"
+)
+
+func TestAgree(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for _, test := range []struct {
+		n          float64
+		word, want string
+	}{
+		{0, "is", "are"},
+		{1, "is", "is"},
+		{1, "are", "is"},
+		{2, "is", "are"},
+		{-1, "was", "was"},
+		{1.5, "has", "have"},
+		{3, "this", "these"},
+		{1, "those", "that"},
+		{2, "it", "they"},
+		{2, "doesn't", "don't"},
+		{2, "Is", "Are"},
+		{2, "WAS", "WERE"},
+		{2, "was deleted", "were deleted"},
+		{1, "delete", "deletes"},
+		{3, "delete", "delete"},
+		{1, "log in", "logs in"},
+		{1, "", ""},
+	} {
+		if got := rs.Agree(test.n, test.word); got != test.want {
+			t.Error("want", test.want, "got", got)
+		}
+	}
+}
+
+func TestAgreeNoun(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for _, test := range []struct {
+		noun, word, want string
+	}{
+		{"user", "is", "is"},
+		{"users", "is", "are"},
+		{"people", "was", "were"},
+		{"sheep", "was", "was"},
+		{"equipment", "has", "has"},
+		{"data", "is", "is"},
+		{"sensor_data", "are", "is"},
+		{"items", "this", "these"},
+		{"user", "delete", "deletes"},
+		{"users", "delete", "delete"},
+	} {
+		if got := rs.AgreeNoun(test.noun, test.word); got != test.want {
+			t.Error("want", test.want, "got", got)
+		}
+	}
+}
+
+func TestAddAgreement(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	rs.AddAgreement("there's", "there are")
+	if want, got := "there are", rs.Agree(0, "there's"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	rs.AddMassNoun("media")
+	if want, got := "is", rs.AgreeNoun("media", "are"); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
@@ -0,0 +1,159 @@
+package inflect
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// words whose first letter doesn't sound the way it's spelled.
+func addDefaultArticles(rs *Ruleset) {
+	for _, prefix := range []string{
+		"eu", "ewe", "ubi", "uku", "unanim", "uni", "unidim", "unidir", "ura", "ure", "uri", "uro",
+		"usa", "use", "usu", "uten", "uti", "uto",
+	} {
+		rs.AddArticle(prefix, "a")
+	}
+	for _, prefix := range []string{
+		// the negations which start like "uni": "unidentified", "unissued", "unironic"
+		"heir", "honest", "honor", "honour", "hour", "unid", "unim", "unin", "uniro", "uniss",
+	} {
+		rs.AddArticle(prefix, "an")
+	}
+	rs.AddArticleExact("one", "a", true)
+	rs.AddArticleExact("once", "a", true)
+}
+
+// AddArticle: the indefinite article for words starting with prefix
+// for example: "hour" -> "an" makes "an hour" and "an hourly rate".
+// the longest matching prefix wins.
+func (rs *Ruleset) AddArticle(prefix, article string) {
+	rs.AddArticleExact(prefix, article, false)
+}
+
+// same as AddArticle but you can set `exact` to force a full word match
+func (rs *Ruleset) AddArticleExact(word, article string, exact bool) {
+	rs.articles = append(rs.articles, Rule{
+		match: strings.ToLower(word),
+		sub:   article,
+		exact: exact,
+	})
+}
+
+// Article: the indefinite article, "a" or "an", based on how word sounds.
+// "an order", "a university", "an hour", "an HTML page", "a NASA mission", "an 8", "an X-ray".
+// words added with AddArticleExact come first, then numbers, letters and initialisms, then prefixes.
+func (rs *Ruleset) Article(word string) (ret string) {
+	first := word
+	if words := splitWords(word); len(words) > 0 {
+		first = words[0].in(word)
+	}
+	lower := rs.toLower(first)
+	if article, ok := rs.findArticle(lower, true); ok {
+		ret = article
+	} else if c, _ := utf8.DecodeRuneInString(first); unicode.IsDigit(c) {
+		ret = numberArticle(first)
+	} else if isLetter(first) || isInitialism(first) {
+		ret = letterArticle(c)
+	} else if article, ok := rs.findArticle(lower, false); ok {
+		ret = article
+	} else if c, _ := utf8.DecodeRuneInString(lower); strings.ContainsRune("aeiou", c) {
+		ret = "an"
+	} else {
+		ret = "a"
+	}
+	return
+}
+
+// WithArticle: "order" -> "an order"
+func (rs *Ruleset) WithArticle(word string) string {
+	return rs.Article(word) + " " + word
+}
+
+// either the exact rules or the longest prefix; later rules beat earlier ones.
+func (rs *Ruleset) findArticle(lower string, exact bool) (ret string, okay bool) {
+	best := -1
+	for i := len(rs.articles) - 1; i >= 0; i-- {
+		if rule := rs.articles[i]; rule.exact && exact {
+			if lower == rule.match {
+				ret, okay = rule.sub, true
+				break
+			}
+		} else if !rule.exact && !exact && len(rule.match) > best && strings.HasPrefix(lower, rule.match) {
+			ret, okay, best = rule.sub, true, len(rule.match)
+		}
+	}
+	return
+}
+
+// a word of just one letter is read by its name: "F", "S", the "X" of "X-ray"
+func isLetter(word string) bool {
+	c, n := utf8.DecodeRuneInString(word)
+	return n == len(word) && unicode.IsLetter(c)
+}
+
+// uppercase words with no vowels, or of three letters or less, are spelled out: "HTML", "FBI".
+// longer words with vowels are read as words: "NASA"
+func isInitialism(word string) bool {
+	var letters int
+	var vowels bool
+	for _, c := range word {
+		if unicode.IsLower(c) {
+			return false
+		} else if unicode.IsLetter(c) {
+			letters++
+			vowels = vowels || strings.ContainsRune("AEIOU", c)
+		}
+	}
+	return letters > 1 && (!vowels || letters <= 3)
+}
+
+// the names of these letters start with a vowel sound: "an F", "an HTML page".
+func letterArticle(c rune) (ret string) {
+	if strings.ContainsRune("AEFHILMNORSX", unicode.ToUpper(c)) {
+		ret = "an"
+	} else {
+		ret = "a"
+	}
+	return
+}
+
+// numbers which start with "eight", "eleven", or "eighteen" take "an": "an 8", "an 11", "an 18,000"
+func numberArticle(num string) (ret string) {
+	digits := strings.Map(func(c rune) (ret rune) {
+		if unicode.IsDigit(c) {
+			ret = c
+		} else if c == ',' {
+			ret = -1
+		} else {
+			ret = 'x' // stop at a decimal point or any other character
+		}
+		return
+	}, num)
+	if end := strings.IndexRune(digits, 'x'); end >= 0 {
+		digits = digits[:end]
+	}
+	ret = "a"
+	if strings.HasPrefix(digits, "8") {
+		ret = "an"
+	} else if (strings.HasPrefix(digits, "11") || strings.HasPrefix(digits, "18")) && len(digits)%3 == 2 {
+		ret = "an"
+	}
+	return
+}
+
+func AddArticle(prefix, article string) {
+	Rules.AddArticle(prefix, article)
+}
+
+func AddArticleExact(word, article string, exact bool) {
+	Rules.AddArticleExact(word, article, exact)
+}
+
+func Article(word string) string {
+	return Rules.Article(word)
+}
+
+func WithArticle(word string) string {
+	return Rules.WithArticle(word)
+}
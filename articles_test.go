@@ -0,0 +1,83 @@
+package inflect
+
+import (
+	"testing"
+)
+
+var WordToArticle = map[string]string{
+	"order":          "an order",
+	"box":            "a box",
+	"apple":          "an apple",
+	"Order":          "an Order",
+	"hour":           "an hour",
+	"hourly rate":    "an hourly rate",
+	"honest man":     "an honest man",
+	"heir":           "an heir",
+	"house":          "a house",
+	"university":     "a university",
+	"unicorn":        "a unicorn",
+	"uninteresting":  "an uninteresting",
+	"unidentified":   "an unidentified",
+	"unidirectional": "a unidirectional",
+	"unissued":       "an unissued",
+	"unironic":       "an unironic",
+	"umbrella":       "an umbrella",
+	"user":           "a user",
+	"usual":          "a usual",
+	"utility":        "a utility",
+	"European":       "a European",
+	"ewe":            "a ewe",
+	"one-time fee":   "a one-time fee",
+	"onerous task":   "an onerous task",
+	"FBI agent":      "an FBI agent",
+	"HTML page":      "an HTML page",
+	"URL":            "a URL",
+	"SQL query":      "an SQL query",
+	"USB drive":      "a USB drive",
+	"NASA mission":   "a NASA mission",
+	"API":            "an API",
+	"UFO":            "a UFO",
+	"F":              "an F",
+	"S grade":        "an S grade",
+	"R":              "an R",
+	"B":              "a B",
+	"X-ray":          "an X-ray",
+	"u-turn":         "a u-turn",
+	"EU directive":   "an EU directive",
+	"USA":            "a USA",
+	"8":              "an 8",
+	"80":             "an 80",
+	"800":            "an 800",
+	"11":             "an 11",
+	"18":             "an 18",
+	"18,000":         "an 18,000",
+	"110":            "a 110",
+	"1":              "a 1",
+	"100":            "a 100",
+	"8.5":            "an 8.5",
+	"":               "a ",
+}
+
+func TestArticle(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for word, phrase := range WordToArticle {
+		if want, got := phrase, rs.WithArticle(word); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+func TestAddArticle(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	if want, got := "a", rs.Article("herb"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	rs.AddArticle("herb", "an")
+	if want, got := "an", rs.Article("herb"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	rs.AddArticleExact("nsfw", "an", true)
+	if want, got := "an", rs.Article("NSFW"); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
@@ -0,0 +1,68 @@
+package inflect
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+)
+
+//go:generate go run ./internal/gendict -in data/words.txt -out data/dictionary.txt
+
+//go:embed data/dictionary.txt
+var dictionaryList string
+
+var dictionaryOnce sync.Once
+var dictionary struct {
+	irregulars   irregulars
+	uncountables []string
+	nouns        map[string]bool // the singular form of every word
+}
+
+// the parsed contents of data/dictionary.txt
+func loadDictionary() {
+	dictionaryOnce.Do(func() {
+		dictionary.nouns = make(map[string]bool)
+		for _, line := range strings.Split(dictionaryList, "\n") {
+			if !strings.HasPrefix(line, "#") {
+				switch words := strings.Fields(line); len(words) {
+				case 1:
+					dictionary.uncountables = append(dictionary.uncountables, words[0])
+					dictionary.nouns[words[0]] = true
+				case 2:
+					dictionary.irregulars = append(dictionary.irregulars, [2]string{words[0], words[1]})
+					dictionary.nouns[words[0]] = true
+				}
+			}
+		}
+	})
+}
+
+// AddDictionary of irregular and uncountable English nouns to the passed rules.
+// for example: "goose" -> "geese", "cactus" -> "cacti", and "feedback" -> "feedback".
+// words match exactly, or as the last word of a compound: "snow_goose" -> "snow_geese";
+// nouns which end with the same letters, such as "mongoose", aren't changed.
+// the dictionary is rebuilt from data/words.txt with `go generate`.
+// Returns the same ruleset for easier statement chaining
+func AddDictionary(rs *Ruleset) *Ruleset {
+	loadDictionary()
+	for _, pair := range dictionary.irregulars {
+		rs.AddIrregularExact(pair[0], pair[1])
+	}
+	for _, word := range dictionary.uncountables {
+		rs.AddUncountable(word)
+	}
+	rs.lexicons = append(rs.lexicons, dictionary.nouns)
+	return rs
+}
+
+// like AddIrregular, but only for whole words, or the last word of a compound.
+func (rs *Ruleset) AddIrregularExact(singular, plural string) {
+	rs.AddPluralExact(singular, plural, true)
+	rs.AddPluralExact(plural, plural, true)
+	rs.AddSingularExact(plural, singular, true)
+	rs.AddSingularExact(singular, singular, true)
+}
+
+func AddIrregularExact(singular, plural string) {
+	Rules.AddIrregularExact(singular, plural)
+}
@@ -0,0 +1,116 @@
+package inflect
+
+import (
+	"strings"
+	"testing"
+)
+
+var DictionarySingularToPlural = map[string]string{
+	"goose":      "geese",
+	"snow_goose": "snow_geese",
+	"SnowGoose":  "SnowGeese",
+	"mongoose":   "mongooses",
+	"tooth":      "teeth",
+	"foot":       "feet",
+	"cactus":     "cacti",
+	"criterion":  "criteria",
+	"phenomenon": "phenomena",
+	"deer":       "deer",
+	"moose":      "moose",
+	"aircraft":   "aircraft",
+	"salmon":     "salmon",
+	"luggage":    "luggage",
+	"feedback":   "feedback",
+	"human":      "humans",
+	"toe":        "toes",
+	"bonus":      "bonuses",
+	"quota":      "quotas",
+	"leaf":       "leaves",
+	"safe":       "safes",
+	"person":     "people",
+	"box":        "boxes",
+	"larynx":     "larynges",
+	"chateau":    "chateaux",
+	"spectrum":   "spectra",
+	"walrus":     "walruses",
+	"calcium":    "calcium",
+	"scissors":   "scissors",
+	"jazz":       "jazz",
+	"angelfish":  "angelfish",
+}
+
+func TestDictionary(t *testing.T) {
+	rs := AddDictionary(AddDefaultRules(&Ruleset{}))
+	for singular, plural := range DictionarySingularToPlural {
+		if want, got := plural, rs.Pluralize(singular); got != want {
+			t.Error("want", want, "got", got)
+		}
+		if want, got := plural, rs.Pluralize(plural); got != want {
+			t.Error("want", want, "got", got)
+		}
+		if want, got := singular, rs.Singularize(plural); got != want {
+			t.Error("want", want, "got", got)
+		}
+		if want, got := singular, rs.Singularize(singular); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+func TestDictionaryIsOptional(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	if want, got := "gooses", rs.Pluralize("goose"); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
+
+func TestDictionaryThenStyle(t *testing.T) {
+	rs := AddDictionary(AddDefaultRules(&Ruleset{}))
+	AddStyle(rs, ModernStyle)
+	if want, got := "cactuses", rs.Pluralize("cactus"); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
+
+// the dictionary shouldn't repeat regular plurals which the default rules already get right.
+func TestDictionaryHasNoRegularPlurals(t *testing.T) {
+	loadDictionary()
+	rs := AddDefaultRules(&Ruleset{})
+	for _, pair := range dictionary.irregulars {
+		singular, plural := pair[0], pair[1]
+		regular := plural == singular+"s" || plural == singular+"es" ||
+			(strings.HasSuffix(singular, "y") && plural == strings.TrimSuffix(singular, "y")+"ies")
+		if regular && rs.Pluralize(singular) == plural && rs.Singularize(plural) == singular {
+			t.Error("unneeded", singular, plural)
+		}
+	}
+}
+
+// the dictionary's uncountables shouldn't stop everyday countable nouns from pluralizing.
+func TestDictionaryCountables(t *testing.T) {
+	rs := AddDictionary(AddDefaultRules(&Ruleset{}))
+	for singular, plural := range map[string]string{
+		"UserPermission": "UserPermissions",
+		"HearingAid":     "HearingAids",
+		"administration": "administrations",
+		"fraternity":     "fraternities",
+		"absurdity":      "absurdities",
+		"construction":   "constructions",
+		"ambition":       "ambitions",
+		"fiction":        "fictions",
+		"behavior":       "behaviors",
+		"chocolate":      "chocolates",
+		"gear":           "gears",
+		"folk":           "folks",
+		"likelihood":     "likelihoods",
+		"energy":         "energies",
+		"friendship":     "friendships",
+	} {
+		if want, got := plural, rs.Pluralize(singular); got != want {
+			t.Error("want", want, "got", got)
+		}
+		if want, got := NumberNo, rs.IsPlural(singular); got != want {
+			t.Error(singular, "want", want, "got", got)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package inflect
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// DurationOptions change how HumanizeDuration and RelativeTime write durations.
+type DurationOptions struct {
+	MaxUnits    int           // the most units to show: 2 writes "1 hour 5 minutes"; 1 when zero.
+	Granularity time.Duration // the smallest unit to show; time.Second when zero. time.Minute never shows seconds.
+	Rounding    Rounding      // how to round the smallest unit shown; RoundHalfUp by default.
+	Approximate bool          // start rounded durations with "about": "about a year", "about 3 hours"
+	Spell       bool          // spell out the counts: "three minutes"
+	Separator   string        // between units; a space when empty.
+}
+
+// months and years have a fixed length: 30 and 365 days.
+var durationUnits = []struct {
+	name string
+	size time.Duration
+}{
+	{"year", 365 * 24 * time.Hour},
+	{"month", 30 * 24 * time.Hour},
+	{"week", 7 * 24 * time.Hour},
+	{"day", 24 * time.Hour},
+	{"hour", time.Hour},
+	{"minute", time.Minute},
+	{"second", time.Second},
+	{"millisecond", time.Millisecond},
+}
+
+// HumanizeDuration: 3*time.Minute -> "3 minutes", or with options: "1 hour 5 minutes", "about a year".
+// the unit names use PluralizeCount, so any custom rules of the ruleset apply. negative durations are written as positive ones.
+func (rs *Ruleset) HumanizeDuration(d time.Duration, opts DurationOptions) string {
+	ret, _ := rs.humanizeDuration(absDuration(d), opts)
+	return ret
+}
+
+// RelativeTime: the time between now and t: "3 minutes ago", "in 2 days", or "just now".
+// now is a parameter so that results can be repeated; most callers pass time.Now().
+func (rs *Ruleset) RelativeTime(t, now time.Time, opts DurationOptions) (ret string) {
+	d := t.Sub(now)
+	if str, zero := rs.humanizeDuration(absDuration(d), opts); zero {
+		ret = "just now"
+	} else if d < 0 {
+		ret = str + " ago"
+	} else {
+		ret = "in " + str
+	}
+	return
+}
+
+// returns true if the duration rounded to zero.
+func (rs *Ruleset) humanizeDuration(d time.Duration, opts DurationOptions) (ret string, zero bool) {
+	granularity, maxUnits, sep := opts.Granularity, opts.MaxUnits, opts.Separator
+	if granularity <= 0 {
+		granularity = time.Second
+	}
+	if maxUnits <= 0 {
+		maxUnits = 1
+	}
+	if len(sep) == 0 {
+		sep = " "
+	}
+	units := durationUnits[:1]
+	for i, u := range durationUnits {
+		if u.size >= granularity {
+			units = durationUnits[:i+1]
+		}
+	}
+	// split d into units greedily, starting with the largest which fits;
+	// then round only the last unit shown, carrying upwards: 59.6 minutes -> 1 hour.
+	// years and months don't divide evenly into each other, so rounding the whole duration can't work.
+	first := len(units) - 1
+	for i, u := range units {
+		if d >= u.size {
+			first = i
+			break
+		}
+	}
+	last := first + maxUnits - 1
+	if last >= len(units) {
+		last = len(units) - 1
+	}
+	counts := make([]time.Duration, len(units))
+	rem := d
+	for i := first; i <= last; i++ {
+		counts[i], rem = rem/units[i].size, rem%units[i].size
+	}
+	if roundsUp(counts[last], rem, units[last].size, opts.Rounding) {
+		counts[last]++
+	}
+	for i := last; i > 0 && counts[i]*units[i].size >= units[i-1].size; i-- {
+		carry := counts[i] * units[i].size
+		counts[i-1] += carry / units[i-1].size
+		counts[i] = carry % units[i-1].size / units[i].size
+	}
+	approx := opts.Approximate && rem != 0
+	var parts []string
+	for i := 0; i <= last; i++ {
+		if cnt := counts[i]; cnt > 0 {
+			if cnt == 1 && approx && len(parts) == 0 && isZero(counts[i+1:last+1]) {
+				parts = append(parts, rs.WithArticle(units[i].name)) // "about an hour"
+			} else {
+				parts = append(parts, rs.ToQuantity(float64(cnt), units[i].name, QuantityOptions{Spell: opts.Spell}))
+			}
+		}
+	}
+	if zero = len(parts) == 0; zero {
+		ret = rs.ToQuantity(0, units[len(units)-1].name, QuantityOptions{Spell: opts.Spell})
+	} else {
+		ret = strings.Join(parts, sep)
+	}
+	if approx {
+		ret = "about " + ret
+	}
+	return
+}
+
+// whether the remainder r of some count of unit should round the count up.
+func roundsUp(cnt, r, unit time.Duration, rounding Rounding) (ret bool) {
+	switch rounding {
+	case RoundHalfEven:
+		ret = r*2 > unit || (r*2 == unit && cnt%2 == 1)
+	case RoundDown:
+	case RoundUp:
+		ret = r > 0
+	default:
+		ret = r*2 >= unit
+	}
+	return
+}
+
+func isZero(counts []time.Duration) (okay bool) {
+	okay = true
+	for _, cnt := range counts {
+		if cnt != 0 {
+			okay = false
+			break
+		}
+	}
+	return
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		if d = -d; d < 0 {
+			d = math.MaxInt64 // the negative of math.MinInt64 overflows
+		}
+	}
+	return d
+}
+
+func HumanizeDuration(d time.Duration, opts DurationOptions) string {
+	return Rules.HumanizeDuration(d, opts)
+}
+
+func RelativeTime(t, now time.Time, opts DurationOptions) string {
+	return Rules.RelativeTime(t, now, opts)
+}
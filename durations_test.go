@@ -0,0 +1,89 @@
+package inflect
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestHumanizeDuration(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	day := 24 * time.Hour
+	for _, test := range []struct {
+		d    time.Duration
+		opts DurationOptions
+		want string
+	}{
+		{0, DurationOptions{}, "0 seconds"},
+		{time.Second, DurationOptions{}, "1 second"},
+		{3 * time.Minute, DurationOptions{}, "3 minutes"},
+		{-3 * time.Minute, DurationOptions{}, "3 minutes"},
+		{65 * time.Minute, DurationOptions{}, "1 hour"},
+		{65 * time.Minute, DurationOptions{MaxUnits: 2}, "1 hour 5 minutes"},
+		{65*time.Minute + 20*time.Second, DurationOptions{MaxUnits: 3}, "1 hour 5 minutes 20 seconds"},
+		{65*time.Minute + 20*time.Second, DurationOptions{MaxUnits: 3, Granularity: time.Minute}, "1 hour 5 minutes"},
+		{65*time.Minute + 20*time.Second, DurationOptions{MaxUnits: 2, Separator: ", "}, "1 hour, 5 minutes"},
+		{90 * time.Minute, DurationOptions{}, "2 hours"},
+		{90 * time.Minute, DurationOptions{Rounding: RoundDown}, "1 hour"},
+		{150 * time.Minute, DurationOptions{Rounding: RoundHalfEven}, "2 hours"},
+		{61 * time.Minute, DurationOptions{Rounding: RoundUp}, "2 hours"},
+		{59*time.Minute + 40*time.Second, DurationOptions{}, "1 hour"},
+		{59*time.Minute + 59*time.Second + 700*time.Millisecond, DurationOptions{MaxUnits: 2}, "1 hour"},
+		{370 * day, DurationOptions{Approximate: true}, "about a year"},
+		{65 * time.Minute, DurationOptions{Approximate: true}, "about an hour"},
+		{3*time.Hour + time.Minute, DurationOptions{Approximate: true}, "about 3 hours"},
+		{3 * time.Hour, DurationOptions{Approximate: true}, "3 hours"},
+		{14 * day, DurationOptions{}, "2 weeks"},
+		{3 * time.Minute, DurationOptions{Spell: true}, "three minutes"},
+		{1500 * time.Millisecond, DurationOptions{Granularity: time.Millisecond, MaxUnits: 2}, "1 second 500 milliseconds"},
+		{20 * time.Second, DurationOptions{Granularity: time.Minute}, "0 minutes"},
+		{math.MinInt64, DurationOptions{Rounding: RoundUp}, "293 years"},
+		// years and months don't divide evenly
+		{365 * day, DurationOptions{MaxUnits: 2}, "1 year"},
+		{366 * day, DurationOptions{MaxUnits: 2}, "1 year"},
+		{400 * day, DurationOptions{MaxUnits: 2}, "1 year 1 month"},
+		{400 * day, DurationOptions{MaxUnits: 3}, "1 year 1 month 1 week"},
+		{730 * day, DurationOptions{MaxUnits: 2}, "2 years"},
+		{730 * day, DurationOptions{MaxUnits: 3}, "2 years"},
+		{730 * day, DurationOptions{}, "2 years"},
+		{545 * day, DurationOptions{}, "1 year"},
+		{548 * day, DurationOptions{}, "2 years"},
+		{364 * day, DurationOptions{}, "12 months"},
+		{380 * day, DurationOptions{MaxUnits: 2, Approximate: true}, "about 1 year 1 month"},
+		{6*day + 23*time.Hour, DurationOptions{}, "1 week"},
+		{500 * day, DurationOptions{MaxUnits: 4, Granularity: 24 * time.Hour}, "1 year 4 months 2 weeks 1 day"},
+	} {
+		if got := rs.HumanizeDuration(test.d, test.opts); got != test.want {
+			t.Error(test.d, "want", test.want, "got", got)
+		}
+	}
+}
+
+func TestRelativeTime(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		t    time.Time
+		opts DurationOptions
+		want string
+	}{
+		{now.Add(-3 * time.Minute), DurationOptions{}, "3 minutes ago"},
+		{now.AddDate(0, 0, 2), DurationOptions{}, "in 2 days"},
+		{now.Add(-400 * time.Millisecond), DurationOptions{}, "just now"},
+		{now, DurationOptions{}, "just now"},
+		{now.AddDate(-1, 0, -3), DurationOptions{Approximate: true}, "about a year ago"},
+		{now.Add(65 * time.Minute), DurationOptions{MaxUnits: 2}, "in 1 hour 5 minutes"},
+	} {
+		if got := rs.RelativeTime(test.t, now, test.opts); got != test.want {
+			t.Error("want", test.want, "got", got)
+		}
+	}
+}
+
+func TestDurationRules(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	rs.AddIrregular("second", "secs")
+	if want, got := "20 secs", rs.HumanizeDuration(20*time.Second, DurationOptions{}); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
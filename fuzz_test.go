@@ -0,0 +1,300 @@
+package inflect
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+// seed corpus shared by the fuzz targets
+var fuzzSeeds = []string{
+	"", " ", "_", "_id", "-", "::", "a", "A", "é", "ǆ", "Σ", "İ", "ı",
+	"\xff", "\xe2\x82", "person", "PrimarySpokesman", "HTML5HTMLAPI",
+	"funky jeans", "node_child", "schema.foo_bar", "Donald E. Knuth",
+	"-1001", "1031", "Ærøskøbing", "Jones", "CDs", "Ph.D.", "X-ray", "EU", "mother-in-law",
+	"be", "panic", "1<sup>st</sup>", "3ʳᵈ", "1.23M", "twenty-first",
+}
+
+// run fn over arbitrary input, checking that it doesn't panic;
+// that valid input produces valid output; and, optionally, that fn is idempotent.
+func fuzzWord(f *testing.F, fn func(string) string, idempotent bool) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, word string) {
+		got := fn(word)
+		if utf8.ValidString(word) {
+			if !utf8.ValidString(got) {
+				t.Fatalf("invalid utf8 %q from %q", got, word)
+			}
+			if idempotent {
+				if again := fn(got); again != got {
+					t.Fatalf("not idempotent %q -> %q -> %q", word, got, again)
+				}
+			}
+		}
+	})
+}
+
+func FuzzPluralize(f *testing.F)           { fuzzWord(f, Pluralize, false) }
+func FuzzSingularize(f *testing.F)         { fuzzWord(f, Singularize, false) }
+func FuzzCapitalize(f *testing.F)          { fuzzWord(f, Capitalize, true) }
+func FuzzCamelize(f *testing.F)            { fuzzWord(f, Camelize, false) }
+func FuzzCamelizeDownFirst(f *testing.F)   { fuzzWord(f, CamelizeDownFirst, false) }
+func FuzzTitleize(f *testing.F)            { fuzzWord(f, Titleize, false) }
+func FuzzUnderscore(f *testing.F)          { fuzzWord(f, Underscore, true) }
+func FuzzHumanize(f *testing.F)            { fuzzWord(f, Humanize, false) }
+func FuzzForeignKey(f *testing.F)          { fuzzWord(f, ForeignKey, false) }
+func FuzzForeignKeyCondensed(f *testing.F) { fuzzWord(f, ForeignKeyCondensed, false) }
+func FuzzTableize(f *testing.F)            { fuzzWord(f, Tableize, false) }
+func FuzzParameterize(f *testing.F)        { fuzzWord(f, Parameterize, true) }
+func FuzzTypeify(f *testing.F)             { fuzzWord(f, Typeify, false) }
+func FuzzDasherize(f *testing.F)           { fuzzWord(f, Dasherize, true) }
+func FuzzOrdinalize(f *testing.F)          { fuzzWord(f, Ordinalize, false) }
+func FuzzAsciify(f *testing.F)             { fuzzWord(f, Asciify, true) }
+func FuzzPluralizeProper(f *testing.F)     { fuzzWord(f, PluralizeProper, false) }
+func FuzzSingularizeProper(f *testing.F)   { fuzzWord(f, SingularizeProper, false) }
+func FuzzPossessive(f *testing.F)          { fuzzWord(f, Possessive, false) }
+func FuzzPluralPossessive(f *testing.F)    { fuzzWord(f, PluralPossessive, false) }
+func FuzzArticle(f *testing.F)             { fuzzWord(f, WithArticle, false) }
+func FuzzThirdPerson(f *testing.F)         { fuzzWord(f, ThirdPerson, false) }
+func FuzzPastTense(f *testing.F)           { fuzzWord(f, PastTense, false) }
+func FuzzPastParticiple(f *testing.F)      { fuzzWord(f, PastParticiple, false) }
+func FuzzPresentParticiple(f *testing.F)   { fuzzWord(f, PresentParticiple, false) }
+func FuzzComparative(f *testing.F)         { fuzzWord(f, Comparative, false) }
+func FuzzSuperlative(f *testing.F)         { fuzzWord(f, Superlative, false) }
+
+// a word which IsPlural says is plural should already be its own plural.
+func FuzzIsPlural(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+	f.Add("people")
+	f.Add("boxes")
+	f.Fuzz(func(t *testing.T, word string) {
+		if IsPlural(word) == NumberYes {
+			if got := Pluralize(word); got != word {
+				t.Fatalf("%q is plural, but pluralizes to %q", word, got)
+			}
+		}
+		IsSingular(word)
+		IsUncountable(word)
+	})
+}
+
+// Singularize picks the first of the candidates.
+func FuzzSingularCandidates(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+	f.Add("caves")
+	f.Fuzz(func(t *testing.T, word string) {
+		got := SingularCandidates(word)
+		if len(word) == 0 {
+			if len(got) != 0 {
+				t.Fatalf("%q has candidates %q", word, got)
+			}
+		} else if len(got) == 0 || got[0] != Singularize(word) {
+			t.Fatalf("%q has candidates %q, but singularizes to %q", word, got, Singularize(word))
+		}
+	})
+}
+
+// any style can be replaced by another; unknown styles leave the rules alone.
+func FuzzAddStyle(f *testing.F) {
+	f.Add(int(ModernStyle), "index")
+	f.Add(-1, "octopus")
+	f.Add(7, "virus")
+	f.Fuzz(func(t *testing.T, style int, word string) {
+		rs := AddDefaultRules(&Ruleset{})
+		want := rs.Pluralize(word)
+		if e := AddStyle(rs, Style(style)); (e != nil) != (style < int(RailsStyle) || style > int(ClassicalStyle)) {
+			t.Fatalf("style %d returned %v", style, e)
+		}
+		rs.Singularize(word)
+		if e := AddStyle(rs, RailsStyle); e != nil {
+			t.Fatal(e)
+		} else if got := rs.Pluralize(word); got != want {
+			t.Fatalf("style %d changed %q -> %q to %q", style, word, want, got)
+		}
+	})
+}
+
+// a count of one never changes the word; other counts shouldn't panic.
+func FuzzPluralizeCount(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(1.0, seed)
+	}
+	f.Add(0.0, "equipment")
+	f.Add(-3.5, "box")
+	f.Add(math.Inf(1), "box")
+	f.Fuzz(func(t *testing.T, n float64, word string) {
+		if got := PluralizeCount(1, word); got != word {
+			t.Fatalf("%q -> %q", word, got)
+		}
+		PluralizeCount(n, word)
+		ToQuantity(n, word, QuantityOptions{})
+		ToQuantity(n, word, QuantityOptions{Spell: true, No: true})
+		Agree(n, word)
+		AgreeNoun(word, word)
+	})
+}
+
+func FuzzToSentence(f *testing.F) {
+	f.Add("a", "b", "c", "", 0, false)
+	f.Add("users", "", "roles", "de-AT", 2, true)
+	f.Add("", "", "", "ja", -1, false)
+	f.Fuzz(func(t *testing.T, a, b, c, locale string, limit int, or bool) {
+		opts := SentenceOptions{Locale: locale, Limit: limit}
+		if or {
+			opts.Conjunction = ConjunctionOr
+		}
+		for i := 0; i <= 3; i++ {
+			got := ToSentence([]string{a, b, c}[:i], opts)
+			if utf8.ValidString(a) && utf8.ValidString(b) && utf8.ValidString(c) && !utf8.ValidString(got) {
+				t.Fatalf("invalid utf8 %q", got)
+			}
+		}
+	})
+}
+
+// every finite number should write something ParseHumanNumber can read.
+func FuzzHumanNumber(f *testing.F) {
+	f.Add(1234567.0, 0, 0, "", "")
+	f.Add(-1e30, 1, 5, "B", "de")
+	f.Add(0.5, 3, -1, "", "")
+	f.Fuzz(func(t *testing.T, n float64, scale, precision int, unit, locale string) {
+		opts := HumanNumberOptions{Scale: NumberScale(uint(scale) % 4), Precision: precision % 20, Unit: unit, Locale: locale}
+		s := HumanizeNumber(n, opts)
+		if _, e := ParseHumanNumber(s, opts); e != nil && !math.IsNaN(n) && !math.IsInf(n, 0) && unit == "" {
+			t.Fatalf("%v -> %q %v", n, s, e)
+		}
+		ParseHumanNumber(unit, opts)
+	})
+}
+
+func FuzzHumanizeDuration(f *testing.F) {
+	f.Add(int64(time.Hour+5*time.Minute), int64(0), 2, false)
+	f.Add(int64(math.MinInt64), int64(math.MaxInt64), 7, true)
+	f.Fuzz(func(t *testing.T, d, now int64, units int, spell bool) {
+		opts := DurationOptions{MaxUnits: units % 10, Spell: spell, Approximate: spell}
+		HumanizeDuration(time.Duration(d), opts)
+		RelativeTime(time.Unix(0, d), time.Unix(0, now), opts)
+	})
+}
+
+// big numbers should spell out the same as their int64 versions.
+func FuzzBigNumberWords(f *testing.F) {
+	f.Add(int64(0))
+	f.Add(int64(math.MinInt64))
+	f.Fuzz(func(t *testing.T, n int64) {
+		b := big.NewInt(n)
+		if want, got := NumberToWords(n), BigNumberToWords(b); got != want {
+			t.Fatalf("want %q got %q", want, got)
+		}
+		if want, got := OrdinalWords(n), BigOrdinalWords(b); got != want {
+			t.Fatalf("want %q got %q", want, got)
+		}
+		if want, got := OrdinalizeInt(n), OrdinalizeBig(b); got != want {
+			t.Fatalf("want %q got %q", want, got)
+		}
+	})
+}
+
+func FuzzParameterizeJoin(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed, "_")
+	}
+	f.Add("a b", "(")
+	f.Add("a b", "+")
+	f.Fuzz(func(t *testing.T, word, sep string) {
+		got := ParameterizeJoin(word, sep)
+		if utf8.ValidString(word) && utf8.ValidString(sep) && !utf8.ValidString(got) {
+			t.Fatalf("invalid utf8 %q from %q", got, word)
+		}
+	})
+}
+
+// rules added by users shouldn't be able to cause panics either.
+func FuzzRules(f *testing.F) {
+	f.Add("", "", "person")
+	f.Add("x", "", "box")
+	f.Add("HTML", "Html", "HTMLTidy")
+	f.Fuzz(func(t *testing.T, match, sub, word string) {
+		rs := AddDefaultRules(&Ruleset{})
+		rs.AddPlural(match, sub)
+		rs.AddSingular(match, sub)
+		rs.AddIrregular(match, sub)
+		rs.AddHuman(match, sub)
+		rs.AddAcronym(match)
+		rs.AddUncountable(match)
+		rs.Pluralize(word)
+		rs.Singularize(word)
+		rs.Humanize(word)
+		rs.Underscore(word)
+		rs.Tableize(word)
+	})
+}
+
+// spelled out numbers and ordinals should read back as the same number.
+func FuzzNumberWords(f *testing.F) {
+	for _, n := range []int64{0, 1, -1, 12, 21, 105, 1001, 1031, 100000, math.MaxInt64, math.MinInt64} {
+		f.Add(n, false, 0)
+	}
+	f.Fuzz(func(t *testing.T, n int64, british bool, format int) {
+		rs := AddDefaultRules(&Ruleset{})
+		if british {
+			rs.SetNumberStyle(BritishNumbers())
+		}
+		rs.SetOrdinalFormat(OrdinalFormat(uint(format) % 3))
+		for _, x := range []struct {
+			format func(int64) string
+			parse  func(string) (int64, error)
+		}{
+			{rs.NumberToWords, rs.WordsToNumber},
+			{rs.OrdinalWords, rs.Deordinalize},
+			{func(n int64) string { return rs.Ordinalize(strconv.FormatInt(n, 10)) }, rs.Deordinalize},
+		} {
+			s := x.format(n)
+			if got, e := x.parse(s); e != nil || got != n {
+				t.Fatalf("%d -> %q -> %d %v", n, s, got, e)
+			}
+		}
+	})
+}
+
+// parsing arbitrary text shouldn't panic, and any number it reads should survive a round trip.
+func FuzzWordsToNumber(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+	f.Add("a hundred and five")
+	f.Add("twenty-first")
+	f.Fuzz(func(t *testing.T, s string) {
+		if n, e := WordsToNumber(s); e == nil {
+			if got, e := WordsToNumber(NumberToWords(n)); e != nil || got != n {
+				t.Fatalf("%q -> %d -> %d %v", s, n, got, e)
+			}
+		}
+		Deordinalize(s)
+	})
+}
+
+// every roman numeral should read back as the same number; and FromRoman shouldn't panic.
+func FuzzRoman(f *testing.F) {
+	f.Add(1999, "MCMXCIX")
+	f.Add(5001, "V̅I")
+	f.Fuzz(func(t *testing.T, n int, s string) {
+		for _, opts := range []RomanOptions{{}, {Lower: true, Unicode: true, Vinculum: true}} {
+			if numeral, e := FormatRoman(n, opts); e == nil {
+				if got, e := FromRoman(numeral); e != nil || got != n {
+					t.Fatalf("%d -> %q -> %d %v", n, numeral, got, e)
+				}
+			}
+		}
+		FromRoman(s)
+	})
+}
@@ -0,0 +1,241 @@
+package inflect
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// NumberScale picks the units HumanizeNumber uses for large numbers.
+type NumberScale int
+
+const (
+	// SIScale uses metric prefixes, powers of 1000: "1.23M", or with a unit: "1.23 MB"
+	SIScale NumberScale = iota
+	// IECScale uses binary prefixes, powers of 1024: "1.18 MiB"
+	IECScale
+	// ShortScale uses american and modern british names: "1.23 million", "1.23 billion"
+	ShortScale
+	// LongScale uses the traditional european names: "1.23 million", "1.23 thousand million"
+	LongScale
+)
+
+// Rounding picks how HumanizeNumber drops digits.
+type Rounding int
+
+const (
+	RoundHalfUp   Rounding = iota // 1.25 -> 1.3; halves round away from zero.
+	RoundHalfEven                 // 1.25 -> 1.2; halves round to the nearest even digit.
+	RoundDown                     // 1.29 -> 1.2; towards zero.
+	RoundUp                       // 1.21 -> 1.3; away from zero.
+)
+
+// HumanNumberOptions change how HumanizeNumber writes numbers.
+type HumanNumberOptions struct {
+	Scale     NumberScale
+	Precision int      // the most significant digits to show; 3 when zero.
+	Rounding  Rounding // RoundHalfUp by default.
+	KeepZeros bool     // "1.00M" rather than "1M"
+	Unit      string   // written after the prefix: "B" for bytes makes "1.18 MiB"
+	Decimal   string   // the decimal separator; when empty, uses the separator for the Locale.
+	Locale    string   // ex. "de" writes "1,23M"; english when empty.
+}
+
+var (
+	siPrefixes  = []string{"", "k", "M", "G", "T", "P", "E", "Z", "Y", "R", "Q"}
+	iecPrefixes = []string{"", "Ki", "Mi", "Gi", "Ti", "Pi", "Ei", "Zi", "Yi"}
+)
+
+// languages which write decimals with a comma.
+var decimalCommas = []string{
+	"bg", "cs", "da", "de", "el", "es", "fi", "fr", "hr", "hu", "id", "it", "lt", "lv",
+	"nl", "no", "nb", "pl", "pt", "ro", "ru", "sk", "sl", "sr", "sv", "tr", "uk", "vi",
+}
+
+// HumanizeNumber writes large numbers compactly:
+// 1234567 -> "1.23M", or with options: "1.2M", "1.23 million", "1.18 MiB", "1,23M".
+func (rs *Ruleset) HumanizeNumber(n float64, opts HumanNumberOptions) (ret string) {
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		ret = strconv.FormatFloat(n, 'f', -1, 64)
+	} else {
+		base, names := humanScale(opts.Scale)
+		precision := opts.Precision
+		if precision <= 0 {
+			precision = 3
+		}
+		abs, k := math.Abs(n), 0
+		for k+1 < names && abs >= math.Pow(base, float64(k+1)) {
+			k++
+		}
+		value, decimals := roundSignificant(abs/math.Pow(base, float64(k)), precision, opts.Rounding)
+		if value >= base && k+1 < names {
+			// rounding can carry into the next unit: 999.9k -> 1M
+			k++
+			value, decimals = roundSignificant(abs/math.Pow(base, float64(k)), precision, opts.Rounding)
+		}
+		num := strconv.FormatFloat(value, 'f', decimals, 64)
+		if !opts.KeepZeros && strings.Contains(num, ".") {
+			num = strings.TrimSuffix(strings.TrimRight(num, "0"), ".")
+		}
+		if n < 0 && value != 0 {
+			num = "-" + num
+		}
+		num = strings.Replace(num, ".", decimalSeparator(opts), 1)
+		ret = num + humanSuffix(opts, k)
+	}
+	return
+}
+
+// ParseHumanNumber reads numbers written by HumanizeNumber with the same options: "1.23M" -> 1230000.
+// it also accepts an uppercase "K" for SI thousands, and plain numbers: "512".
+func (rs *Ruleset) ParseHumanNumber(s string, opts HumanNumberOptions) (ret float64, err error) {
+	text := strings.TrimSpace(s)
+	end := strings.IndexFunc(text, func(c rune) bool {
+		return !(unicode.IsDigit(c) || c == '-' || c == '+' || strings.ContainsRune(decimalSeparator(opts), c))
+	})
+	if end < 0 {
+		end = len(text)
+	}
+	num := strings.Replace(text[:end], decimalSeparator(opts), ".", 1)
+	suffix := strings.TrimSpace(text[end:])
+	if len(num) == 0 {
+		err = &NumberError{Input: s, Err: ErrNoNumber}
+	} else if n, e := strconv.ParseFloat(num, 64); e != nil {
+		err = &NumberError{Input: s, Word: text[:end], Err: ErrUnknownWord}
+	} else if k, ok := parseHumanSuffix(opts, suffix); !ok {
+		err = &NumberError{Input: s, Word: suffix, Err: ErrUnknownWord}
+	} else {
+		base, _ := humanScale(opts.Scale)
+		ret = n * math.Pow(base, float64(k))
+	}
+	return
+}
+
+// the base of the scale, and the number of unit names it has.
+func humanScale(scale NumberScale) (base float64, names int) {
+	switch scale {
+	case IECScale:
+		base, names = 1024, len(iecPrefixes)
+	case LongScale:
+		base, names = 1000, 2*(len(scales)-2)+1 // thousand, million, thousand million, billion, ...
+	case ShortScale:
+		base, names = 1000, len(scales)
+	default:
+		base, names = 1000, len(siPrefixes)
+	}
+	return
+}
+
+// the name of the k-th power of the base; "" for k = 0.
+func humanName(scale NumberScale, k int) (ret string) {
+	switch scale {
+	case IECScale:
+		ret = iecPrefixes[k]
+	case ShortScale:
+		ret = scales[k]
+	case LongScale:
+		// million is 1000^2, billion 1000^4, trillion 1000^6; the odd powers add "thousand".
+		if k < 2 {
+			ret = scales[k]
+		} else if name := scales[k/2+1]; k%2 == 0 {
+			ret = name
+		} else {
+			ret = "thousand " + name
+		}
+	default:
+		ret = siPrefixes[k]
+	}
+	return
+}
+
+// the text after the number: "M", " MB", " MiB", " million"
+func humanSuffix(opts HumanNumberOptions, k int) (ret string) {
+	name := humanName(opts.Scale, k)
+	switch opts.Scale {
+	case ShortScale, LongScale:
+		ret = joinNonEmpty(name, opts.Unit)
+	default:
+		if len(opts.Unit) > 0 || (opts.Scale == IECScale && len(name) > 0) {
+			ret = " " + name + opts.Unit
+		} else {
+			ret = name
+		}
+	}
+	return
+}
+
+// returns the power of the base for the text after a number.
+func parseHumanSuffix(opts HumanNumberOptions, suffix string) (ret int, okay bool) {
+	_, names := humanScale(opts.Scale)
+	okay = len(suffix) == 0 // a plain number
+	for k := 0; k < names && !okay; k++ {
+		if want := strings.TrimSpace(humanSuffix(opts, k)); suffix == want {
+			ret, okay = k, true
+		} else if opts.Scale == SIScale && k == 1 && suffix == strings.ToUpper(want[:1])+want[1:] {
+			ret, okay = k, true // "1K"
+		}
+	}
+	return
+}
+
+func joinNonEmpty(parts ...string) (ret string) {
+	for _, p := range parts {
+		if len(p) > 0 {
+			ret += " " + p
+		}
+	}
+	return
+}
+
+func decimalSeparator(opts HumanNumberOptions) (ret string) {
+	if ret = opts.Decimal; len(ret) == 0 {
+		ret = "."
+		lang := strings.ToLower(opts.Locale)
+		if i := strings.IndexAny(lang, "-_"); i > 0 {
+			lang = lang[:i]
+		}
+		for _, l := range decimalCommas {
+			if l == lang {
+				ret = ","
+				break
+			}
+		}
+	}
+	return
+}
+
+// round v ( >= 0 ) to the passed number of significant digits;
+// returns the rounded value and the number of digits after the decimal point.
+func roundSignificant(v float64, digits int, rounding Rounding) (ret float64, decimals int) {
+	if v > 0 {
+		if whole := int(math.Floor(math.Log10(v))) + 1; whole < digits {
+			decimals = digits - whole
+		}
+		if decimals > 20 {
+			decimals = 20 // tiny numbers round to zero
+		}
+	}
+	scale := math.Pow(10, float64(decimals))
+	x := v * scale
+	switch rounding {
+	case RoundHalfEven:
+		x = math.RoundToEven(x)
+	case RoundDown:
+		x = math.Floor(x + 1e-9) // allow for float error: 0.29 * 100 = 28.999999999999996
+	case RoundUp:
+		x = math.Ceil(x - 1e-9)
+	default:
+		x = math.Round(x)
+	}
+	ret = x / scale
+	return
+}
+
+func HumanizeNumber(n float64, opts HumanNumberOptions) string {
+	return Rules.HumanizeNumber(n, opts)
+}
+
+func ParseHumanNumber(s string, opts HumanNumberOptions) (float64, error) {
+	return Rules.ParseHumanNumber(s, opts)
+}
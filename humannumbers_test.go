@@ -0,0 +1,90 @@
+package inflect
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHumanizeNumber(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for _, test := range []struct {
+		n    float64
+		opts HumanNumberOptions
+		want string
+	}{
+		{0, HumanNumberOptions{}, "0"},
+		{512, HumanNumberOptions{}, "512"},
+		{1000, HumanNumberOptions{}, "1k"},
+		{1234567, HumanNumberOptions{}, "1.23M"},
+		{1234567, HumanNumberOptions{Precision: 2}, "1.2M"},
+		{-1234567, HumanNumberOptions{Precision: 2}, "-1.2M"},
+		{1000000, HumanNumberOptions{KeepZeros: true}, "1.00M"},
+		{999999, HumanNumberOptions{}, "1M"},
+		{1234567, HumanNumberOptions{Unit: "B"}, "1.23 MB"},
+		{512, HumanNumberOptions{Unit: "B"}, "512 B"},
+		{1234567, HumanNumberOptions{Scale: IECScale, Unit: "B"}, "1.18 MiB"},
+		{1024, HumanNumberOptions{Scale: IECScale}, "1 Ki"},
+		{1234567, HumanNumberOptions{Scale: ShortScale, Precision: 2}, "1.2 million"},
+		{1234567890, HumanNumberOptions{Scale: ShortScale}, "1.23 billion"},
+		{1234567890, HumanNumberOptions{Scale: LongScale}, "1.23 thousand million"},
+		{1234567890123, HumanNumberOptions{Scale: LongScale}, "1.23 billion"},
+		{1500, HumanNumberOptions{Scale: ShortScale, Unit: "users"}, "1.5 thousand users"},
+		{1234567, HumanNumberOptions{Locale: "de-DE"}, "1,23M"},
+		{1234567, HumanNumberOptions{Decimal: "·"}, "1·23M"},
+		{1250, HumanNumberOptions{Precision: 2}, "1.3k"},
+		{1250, HumanNumberOptions{Precision: 2, Rounding: RoundHalfEven}, "1.2k"},
+		{1290, HumanNumberOptions{Precision: 2, Rounding: RoundDown}, "1.2k"},
+		{1210, HumanNumberOptions{Precision: 2, Rounding: RoundUp}, "1.3k"},
+		{0.5, HumanNumberOptions{}, "0.5"},
+		{math.Inf(1), HumanNumberOptions{}, "+Inf"},
+	} {
+		if got := rs.HumanizeNumber(test.n, test.opts); got != test.want {
+			t.Error(test.n, "want", test.want, "got", got)
+		}
+	}
+}
+
+func TestParseHumanNumber(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for _, test := range []struct {
+		s    string
+		opts HumanNumberOptions
+		want float64
+	}{
+		{"512", HumanNumberOptions{}, 512},
+		{"1.5k", HumanNumberOptions{}, 1500},
+		{"1.5K", HumanNumberOptions{}, 1500},
+		{"-2M", HumanNumberOptions{}, -2000000},
+		{"1.5 MB", HumanNumberOptions{Unit: "B"}, 1500000},
+		{"2 MiB", HumanNumberOptions{Scale: IECScale, Unit: "B"}, 2 * 1024 * 1024},
+		{"1.5 million", HumanNumberOptions{Scale: ShortScale}, 1500000},
+		{"2 thousand million", HumanNumberOptions{Scale: LongScale}, 2e9},
+		{"1,5M", HumanNumberOptions{Locale: "fr"}, 1500000},
+	} {
+		if got, e := rs.ParseHumanNumber(test.s, test.opts); e != nil {
+			t.Error(test.s, e)
+		} else if got != test.want {
+			t.Error(test.s, "want", test.want, "got", got)
+		}
+	}
+	for _, s := range []string{"", "M", "1.5 X", "1..5k", "1.5 billion"} {
+		if _, e := rs.ParseHumanNumber(s, HumanNumberOptions{}); e == nil {
+			t.Errorf("expected an error for %q", s)
+		}
+	}
+}
+
+func TestHumanNumberRoundTrip(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for _, scale := range []NumberScale{SIScale, IECScale, ShortScale, LongScale} {
+		opts := HumanNumberOptions{Scale: scale, Precision: 6}
+		for _, n := range []float64{1, 999, 1024, 123456, 1.5e9, 4.25e15} {
+			s := rs.HumanizeNumber(n, opts)
+			if got, e := rs.ParseHumanNumber(s, opts); e != nil {
+				t.Error(s, e)
+			} else if math.Abs(got-n)/n > 1e-5 {
+				t.Error(n, "->", s, "->", got)
+			}
+		}
+	}
+}
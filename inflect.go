@@ -3,9 +3,9 @@ package inflect
 
 import (
 	"regexp"
-	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 // Rule for transforming a single word.
@@ -17,6 +17,22 @@ type Rule struct {
 // Ruleset of multiple word transformations..
 type Ruleset struct {
 	plurals, singulars, humans, acronyms, uncountables []Rule
+	prepositions, postpositives, compounds, tails      []Rule
+	partitives, articles                               []Rule
+	thirdPersons, pastTenses                           []Rule
+	pastParticiples, presentParticiples                []Rule
+	agreements, massNouns                              []Rule
+	comparatives, superlatives                         []Rule
+	partitiveFallback                                  string
+	lexicons                                           []map[string]bool
+	nouns                                              map[string]bool
+	casing                                             unicode.SpecialCase
+	properNouns                                        bool
+	apostrophes                                        Apostrophes
+	namePossessives                                    NamePossessives
+	numberStyle                                        NumberStyle
+	ordinalFormat                                      OrdinalFormat
+	listStyles                                         map[string]ListStyle
 }
 
 // Rules - a default set of transformations.
@@ -203,6 +219,14 @@ func AddDefaultRules(rs *Ruleset) *Ruleset {
 		{match: "quizzes", sub: "quiz", exact: true},
 		{match: "databases", sub: "database"},
 	}...)
+	// whole words which the common nouns would otherwise mistake: "axes" -> "axis", not "axe".
+	for _, pair := range [][2]string{
+		{"analyses", "analysis"}, {"axes", "axis"}, {"bases", "basis"}, {"crises", "crisis"},
+		{"diagnoses", "diagnosis"}, {"parentheses", "parenthesis"}, {"prognoses", "prognosis"},
+		{"synopses", "synopsis"}, {"testes", "testis"}, {"theses", "thesis"},
+	} {
+		rs.AddSingularExact(pair[0], pair[1], true)
+	}
 	rs.AddIrregular("person", "people")
 	rs.AddIrregular("man", "men")
 	rs.AddIrregular("child", "children")
@@ -215,12 +239,21 @@ func AddDefaultRules(rs *Ruleset) *Ruleset {
 		{exact: true, match: "information"},
 		{exact: true, match: "jeans"},
 		{exact: true, match: "money"},
+		{exact: true, match: "news"},
 		{exact: true, match: "police"},
 		{exact: true, match: "rice"},
 		{exact: true, match: "series"},
 		{exact: true, match: "sheep"},
 		{exact: true, match: "species"},
 	}...)
+	addDefaultPhrases(rs)
+	addDefaultPartitives(rs)
+	addDefaultArticles(rs)
+	addDefaultListStyles(rs)
+	addDefaultVerbs(rs)
+	addDefaultAgreements(rs)
+	addDefaultAdjectives(rs)
+	rs.lexicons = append(rs.lexicons, commonNouns())
 
 	return rs
 }
@@ -274,7 +307,7 @@ func (rs *Ruleset) AddIrregular(singular, plural string) {
 func (rs *Ruleset) AddAcronym(word string) {
 	rs.acronyms = append(rs.acronyms, Rule{
 		match: word,
-		sub:   rs.Titleize(strings.ToLower(word)),
+		sub:   rs.Titleize(rs.toLower(word)),
 	})
 }
 
@@ -284,41 +317,112 @@ func (rs *Ruleset) AddUncountable(word string) {
 	rs.uncountables = append(rs.uncountables, Rule{match: word, exact: true})
 }
 
-// handle multiple words by using the last one
+// handle compound words by checking the whole word, then the last one.
 func (rs *Ruleset) isUncountable(word string) bool {
-	words := strings.Split(word, " ")
-	last := strings.ToLower(words[len(words)-1])
-	_, exact := find(rs.uncountables, last)
+	_, exact := find(rs.uncountables, rs.toLower(word))
+	if !exact {
+		_, last := lastWord(word)
+		_, exact = find(rs.uncountables, rs.toLower(last))
+	}
 	return exact
 }
 
 // returns the plural form of a singular word
 func (rs *Ruleset) Pluralize(word string) (ret string) {
-	if len(word) > 0 {
-		if p, exact := find(rs.plurals, word); exact {
-			ret = p
-		} else if rs.isUncountable(word) {
-			ret = word
-		} else if len(p) > 0 {
-			ret = p // inexact match
-		} else {
-			ret = word + "s"
+	if p, ok := rs.pluralizeTerm(word); ok {
+		ret = p
+	} else if rs.properNouns && isProperNoun(word) {
+		ret = rs.PluralizeProper(word)
+	} else if len(word) > 0 {
+		ret = rs.inflectPhrase(rs.plurals, word, true, rs.pluralizeWord)[0]
+	}
+	return
+}
+
+func (rs *Ruleset) pluralizeWord(word string) []string {
+	p, ok := rs.inflect(rs.plurals, word)
+	if !ok {
+		p = word + matchLastCase(word, "s")
+	}
+	return []string{p}
+}
+
+// returns the singular form of a plural word
+func (rs *Ruleset) Singularize(word string) (ret string) {
+	if p, ok := rs.singularizeTerm(word); ok {
+		ret = p
+	} else if rs.properNouns && isProperNoun(word) {
+		ret = rs.SingularizeProper(word)
+	} else if len(word) > 0 {
+		ret = rs.SingularCandidates(word)[0]
+	}
+	return
+}
+
+// returns the possible singular forms of a plural word, most likely first.
+// when the word is ambiguous, the common nouns from AddDefaultRules and AddNoun help pick between the singulars:
+// "caves" -> "cave", "cafe"
+func (rs *Ruleset) SingularCandidates(word string) (ret []string) {
+	if p, ok := rs.singularizeTerm(word); ok {
+		ret = []string{p}
+	} else if len(word) > 0 {
+		ret = rs.inflectPhrase(rs.singulars, word, false, rs.singularizeWord)
+	}
+	return
+}
+
+func (rs *Ruleset) singularizeWord(word string) []string {
+	p, ok := rs.inflect(rs.singulars, word)
+	if !ok {
+		p = word
+	}
+	return rs.guessSingulars(word, p)
+}
+
+// apply the passed rules to word, returning false if none of them matched.
+// exact rules and uncountables are checked against the whole word first,
+// then against the last word of compounds like "node_sheep" or "FunkyFish".
+// the lowercase last word wins whenever it matches a longer suffix than the word as written.
+// the prefix of a compound word is always kept as is.
+func (rs *Ruleset) inflect(rules []Rule, word string) (ret string, okay bool) {
+	rule, matched := findRule(rules, word)
+	if matched && rule.exact {
+		ret, okay = rule.sub, true
+	} else if prefix, last := lastWord(word); rs.isUncountable(word) {
+		ret, okay = word, true
+	} else {
+		lower := rs.toLower(last)
+		lowered, ok := findRule(rules, lower)
+		if matched && !(ok && (lowered.exact || len(lowered.match) > len(rule.match))) {
+			ret, okay = strings.TrimSuffix(word, rule.match)+rule.sub, true // inexact match
+		} else if ok {
+			// the lowercase last word matched a more specific rule: "NodeOx" -> "NodeOxen"
+			sub := strings.TrimSuffix(lower, lowered.match) + lowered.sub
+			ret, okay = prefix+rs.matchCase(last, sub), true
 		}
 	}
 	return
 }
 
 func find(rules []Rule, word string) (ret string, exact bool) {
+	if rule, ok := findRule(rules, word); ok {
+		ret = strings.TrimSuffix(word, rule.match) + rule.sub
+		exact = rule.exact
+	}
+	return
+}
+
+// the most recently added rule which matches the word
+func findRule(rules []Rule, word string) (ret Rule, okay bool) {
 	for i := len(rules) - 1; i >= 0; i-- {
 		if rule := rules[i]; rule.exact {
 			if word == rule.match {
-				ret = rule.sub
-				exact = true
+				ret, okay = rule, true
 				break
 			}
 		} else {
 			if trimmed := strings.TrimSuffix(word, rule.match); len(trimmed) < len(word) {
-				ret = trimmed + rule.sub
+				ret, okay = rule, true
 				break
 			}
 		}
@@ -326,55 +430,61 @@ func find(rules []Rule, word string) (ret string, exact bool) {
 	return
 }
 
-// returns the singular form of a plural word
-func (rs *Ruleset) Singularize(word string) (ret string) {
-	if len(word) > 0 {
-		if p, exact := find(rs.singulars, word); exact {
-			ret = p
-		} else if rs.isUncountable(word) {
-			ret = word
-		} else if len(p) > 0 {
-			ret = p // inexact match
-		} else {
-			ret = word
-		}
+// give the replacement the same case as the original:
+// "OX" -> "OXEN", "Ox" -> "Oxen", "ox" -> "oxen".
+func (rs *Ruleset) matchCase(orig, sub string) (ret string) {
+	if first, n := utf8.DecodeRuneInString(orig); !unicode.IsUpper(first) {
+		ret = sub
+	} else if n < len(orig) && strings.IndexFunc(orig, unicode.IsLower) < 0 {
+		ret = strings.ToUpperSpecial(rs.casing, sub)
+	} else {
+		ret = rs.Capitalize(sub)
 	}
 	return
 }
 
-// uppercase first character
+// SetSpecialCase changes the casing used when words are upper or lowercased.
+// for example: unicode.TurkishCase maps "i" to "İ" and "I" to "ı".
+// passing nil restores the standard unicode mappings.
+func (rs *Ruleset) SetSpecialCase(casing unicode.SpecialCase) {
+	rs.casing = casing
+}
+
+// titlecase the first letter "élan" -> "Élan", "ǆungla" -> "ǅungla"
 func (rs *Ruleset) Capitalize(word string) string {
-	return strings.ToUpper(word[:1]) + word[1:]
+	return mapFirst(word, rs.casing.ToTitle)
 }
 
 // "dino_party" -> "DinoParty"
 func (rs *Ruleset) Camelize(word string) string {
-	return splitAtCaseChange(word, "", true)
+	return splitAtCaseChange(word, "", true, rs.casing)
 }
 
 // same as Camelcase but with first letter downcased
 func (rs *Ruleset) CamelizeDownFirst(word string) string {
 	word = rs.Camelize(word)
-	return strings.ToLower(word[:1]) + word[1:]
+	return mapFirst(word, rs.casing.ToLower)
 }
 
 // Capitalize every word in sentance "hello there" -> "Hello There"
 func (rs *Ruleset) Titleize(word string) string {
-	return splitAtCaseChange(word, " ", true)
+	return splitAtCaseChange(word, " ", true, rs.casing)
 }
 
 func (rs *Ruleset) safeCaseAcronyms(word string) string {
 	// convert an acroymn like HTML into Html
 	// forward searches not sure why.
 	for _, rule := range rs.acronyms {
-		word = strings.Replace(word, rule.match, rule.sub, -1)
+		if len(rule.match) > 0 {
+			word = strings.Replace(word, rule.match, rule.sub, -1)
+		}
 	}
 	return word
 }
 
 func (rs *Ruleset) seperatedWords(word, sep string) string {
 	word = rs.safeCaseAcronyms(word)
-	return splitAtCaseChange(word, sep, false)
+	return splitAtCaseChange(word, sep, false, rs.casing)
 }
 
 // Underscore lowercase version "BigBen" -> "big_ben"
@@ -390,11 +500,12 @@ func (rs *Ruleset) Humanize(word string) string {
 	}
 	// replace and strings in humans list
 	for i := len(rs.humans) - 1; i >= 0; i-- {
-		rule := rs.humans[i]
-		word = strings.Replace(word, rule.match, rule.sub, -1)
+		if rule := rs.humans[i]; len(rule.match) > 0 {
+			word = strings.Replace(word, rule.match, rule.sub, -1)
+		}
 	}
 	sentance := rs.seperatedWords(word, " ")
-	return strings.ToUpper(sentance[:1]) + sentance[1:]
+	return mapFirst(sentance, rs.casing.ToTitle)
 }
 
 // an underscored foreign key name "Person" -> "person_id"
@@ -421,12 +532,12 @@ func (rs *Ruleset) Parameterize(word string) string {
 
 // param safe dasherized names with custom seperator
 func (rs *Ruleset) ParameterizeJoin(word, sep string) string {
-	word = strings.ToLower(word)
+	word = rs.toLower(word)
 	word = rs.Asciify(word)
 	word = notUrlSafe.ReplaceAllString(word, "")
 	word = strings.Replace(word, " ", sep, -1)
 	if len(sep) > 0 {
-		squash, err := regexp.Compile(sep + "+")
+		squash, err := regexp.Compile("(" + regexp.QuoteMeta(sep) + ")+")
 		if err == nil {
 			word = squash.ReplaceAllString(word, sep)
 		}
@@ -490,28 +601,14 @@ func (rs *Ruleset) Dasherize(word string) string {
 	return rs.seperatedWords(word, "-")
 }
 
-// "1031" -> "1031st"
+// "1031" -> "1031st", "-22" -> "-22nd", "1,001" -> "1,001st".
+// anything which isn't a whole number is returned unchanged. see also SetOrdinalFormat.
 func (rs *Ruleset) Ordinalize(str string) (ret string) {
-	if number, e := strconv.Atoi(str); e != nil {
+	body := strings.TrimRightFunc(str, unicode.IsSpace)
+	if digits, ok := groupedDigits(strings.TrimLeftFunc(body, unicode.IsSpace)); !ok {
 		ret = str
 	} else {
-		var ext string
-		switch abs(number) % 100 {
-		case 11, 12, 13:
-			ext = "th"
-		default:
-			switch abs(number) % 10 {
-			case 1:
-				ext = "st"
-			case 2:
-				ext = "nd"
-			case 3:
-				ext = "rd"
-			default:
-				ext = "th"
-			}
-		}
-		ret = str + ext
+		ret = body + rs.ordinalSuffix(digits) + str[len(body):]
 	}
 	return ret
 }
@@ -548,6 +645,10 @@ func Singularize(word string) string {
 	return Rules.Singularize(word)
 }
 
+func SingularCandidates(word string) []string {
+	return Rules.SingularCandidates(word)
+}
+
 func Capitalize(word string) string {
 	return Rules.Capitalize(word)
 }
@@ -617,11 +718,35 @@ func isSpacerChar(c rune) bool {
 		c == '-'
 }
 
-func splitAtCaseChange(s, sep string, allowCaps bool) string {
+// split an identifier before its final word.
+// the final word starts after the last spacer, or at the last change to uppercase:
+// "funky jeans" -> "funky ", "jeans"; "NodeChild" -> "Node", "Child"; "HTMLTidy" -> "HTML", "Tidy"
+func lastWord(s string) (prefix, last string) {
+	var start, prevAt int
+	var prev, prevPrev rune
+	for i := 0; i < len(s); {
+		c, n := utf8.DecodeRuneInString(s[i:])
+		if isSpacerChar(c) {
+			start = i + n
+		} else if unicode.IsUpper(c) && i > start &&
+			(unicode.IsLower(prev) || unicode.IsDigit(prev)) {
+			start = i
+		} else if unicode.IsLower(c) && unicode.IsUpper(prev) && unicode.IsUpper(prevPrev) &&
+			prevAt > start {
+			start = prevAt
+		}
+		prev, prevPrev, prevAt = c, prev, i
+		i += n
+	}
+	return s[:start], s[start:]
+}
+
+func splitAtCaseChange(s, sep string, allowCaps bool, casing unicode.SpecialCase) string {
 	var word, words strings.Builder
-	for _, c := range s {
+	runes := []rune(s)
+	for i, c := range runes {
 		spacer := isSpacerChar(c)
-		lower := unicode.ToLower(c)
+		lower := lowerRune(runes, i, casing)
 		if word.Len() > 0 {
 			if spacer || lower != c {
 				if words.Len() > 0 {
@@ -634,10 +759,8 @@ func splitAtCaseChange(s, sep string, allowCaps bool) string {
 		if !spacer {
 			if !allowCaps || word.Len() > 0 {
 				word.WriteRune(lower) // write lower case in the middle of the string
-			} else if lower != c {
-				word.WriteRune(c) // on edges, if c was already uppercase; great.
 			} else {
-				word.WriteRune(unicode.ToUpper(c)) // on edge, if c was lower, uppercase it.
+				word.WriteRune(casing.ToTitle(c)) // on edges, titlecase: "ǆ" and "Ǆ" both become "ǅ"
 			}
 		}
 	}
@@ -649,9 +772,39 @@ func splitAtCaseChange(s, sep string, allowCaps bool) string {
 	return words.String()
 }
 
-func abs(x int) int {
-	if x < 0 {
-		x = -x
+// change the first rune of word using fn, leaving the rest of the word as is.
+// combining marks which follow the first rune stay attached to it.
+func mapFirst(word string, fn func(rune) rune) (ret string) {
+	if r, n := utf8.DecodeRuneInString(word); r == utf8.RuneError {
+		ret = word // empty, or not valid utf8
+	} else {
+		ret = string(fn(r)) + word[n:]
 	}
-	return x
+	return
+}
+
+// lowercase every rune in s using the passed casing.
+func lowerString(s string, casing unicode.SpecialCase) string {
+	var out strings.Builder
+	runes := []rune(s)
+	for i := range runes {
+		out.WriteRune(lowerRune(runes, i, casing))
+	}
+	return out.String()
+}
+
+// lowercase the i-th rune using the passed casing.
+// a sigma at the end of a word becomes the final form "ς".
+func lowerRune(runes []rune, i int, casing unicode.SpecialCase) (ret rune) {
+	if c := runes[i]; c == 'Σ' && i > 0 && unicode.IsLetter(runes[i-1]) &&
+		(i+1 == len(runes) || !unicode.IsLetter(runes[i+1])) {
+		ret = 'ς'
+	} else {
+		ret = casing.ToLower(c)
+	}
+	return
+}
+
+func (rs *Ruleset) toLower(s string) string {
+	return lowerString(s, rs.casing)
 }
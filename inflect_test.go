@@ -2,6 +2,7 @@ package inflect
 
 import (
 	"testing"
+	"unicode"
 )
 
 // test data
@@ -684,3 +685,125 @@ func TestPluralizeOfIrregularity(t *testing.T) {
 		}
 	}
 }
+
+var UnicodeCapitalize = map[string]string{
+	"élan":   "Élan",
+	"ǆungla": "ǅungla",
+	"ñandú":  "Ñandú",
+	"е́ж":    "Е́ж",
+	"":       "",
+}
+
+func TestCapitalizeUnicode(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for lower, capitalized := range UnicodeCapitalize {
+		if want, got := capitalized, rs.Capitalize(lower); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+	if want, got := "élan", rs.CamelizeDownFirst("Élan"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	if want, got := "Élan vital", rs.Humanize("élan_vital"); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
+
+func TestFinalSigma(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	rs.AddUncountable("λόγος")
+	if want, got := "ΛΌΓΟΣ", rs.Pluralize("ΛΌΓΟΣ"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	if want, got := "ο_δ_ό_ς", rs.Underscore("ΟΔΌΣ"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	if want, got := "Οδός", rs.Capitalize(rs.toLower("ΟΔΌΣ")); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
+
+func TestTurkishCase(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	rs.SetSpecialCase(unicode.TurkishCase)
+	if want, got := "İstanbul", rs.Capitalize("istanbul"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	if want, got := "ılık", rs.CamelizeDownFirst("Ilık"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	rs.SetSpecialCase(nil)
+	if want, got := "Istanbul", rs.Capitalize("istanbul"); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
+
+func TestEmptyStrings(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for _, fn := range []func(string) string{
+		rs.Capitalize, rs.CamelizeDownFirst, rs.Humanize,
+		rs.Singularize, rs.Titleize, rs.Underscore, rs.Dasherize,
+	} {
+		if want, got := "", fn(""); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+	if want, got := "", rs.Humanize("_id"); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
+
+func TestParameterizeWithSpecialSeparator(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	if want, got := "squeeze+separators", rs.ParameterizeJoin("Squeeze   separators", "+"); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
+
+var CompoundToPlural = map[string]string{
+	"FunkyFish":      "FunkyFish",
+	"node_sheep":     "node_sheep",
+	"node-sheep":     "node-sheep",
+	"funkyFish":      "funkyFish",
+	"funky jeans":    "funky jeans",
+	"HTMLSheep":      "HTMLSheep",
+	"NodeOx":         "NodeOxen",
+	"node_ox":        "node_oxen",
+	"NODE_OX":        "NODE_OXEN",
+	"PopQuiz":        "PopQuizzes",
+	"NodeChild":      "NodeChildren",
+	"Sheepdog":       "Sheepdogs",
+	"fishmonger":     "fishmongers",
+	"box":            "boxes",
+	"Information":    "Information",
+	"InfoSheet":      "InfoSheets",
+	"customer_money": "customer_money",
+}
+
+func TestCompoundWords(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for singular, plural := range CompoundToPlural {
+		if want, got := plural, rs.Pluralize(singular); got != want {
+			t.Error("want", want, "got", got)
+		}
+		if want, got := singular, rs.Singularize(plural); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+func TestLastWord(t *testing.T) {
+	for word, split := range map[string][2]string{
+		"funky jeans": {"funky ", "jeans"},
+		"NodeChild":   {"Node", "Child"},
+		"HTMLTidy":    {"HTML", "Tidy"},
+		"Area51Sheep": {"Area51", "Sheep"},
+		"HTML":        {"", "HTML"},
+		"node_":       {"node_", ""},
+		"\xffA":       {"", "\xffA"},
+	} {
+		if prefix, last := lastWord(word); prefix != split[0] || last != split[1] {
+			t.Error("want", split, "got", prefix, last)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// Gendict rebuilds the dictionary of irregular and uncountable nouns used by inflect.AddDictionary.
+// It reads a hand edited list of words, checks it for mistakes, and writes a sorted list without duplicates.
+//
+//	go run ./internal/gendict -in data/words.txt -out data/dictionary.txt
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+func main() {
+	in := flag.String("in", "data/words.txt", "hand edited list of words")
+	out := flag.String("out", "data/dictionary.txt", "generated dictionary")
+	flag.Parse()
+	if e := gen(*in, *out); e != nil {
+		log.Fatal(e)
+	}
+}
+
+func gen(inPath, outPath string) (err error) {
+	if fin, e := os.Open(inPath); e != nil {
+		err = e
+	} else {
+		defer fin.Close()
+		if d, e := read(fin); e != nil {
+			err = fmt.Errorf("%s:%w", inPath, e)
+		} else if fout, e := os.Create(outPath); e != nil {
+			err = e
+		} else {
+			err = d.write(fout)
+			if e := fout.Close(); err == nil {
+				err = e
+			}
+		}
+	}
+	return
+}
+
+type dictionary struct {
+	plurals      map[string]string // singular to plural
+	singulars    map[string]string // plural to singular
+	uncountables map[string]bool
+}
+
+func read(in io.Reader) (ret dictionary, err error) {
+	d := dictionary{
+		plurals:      make(map[string]string),
+		singulars:    make(map[string]string),
+		uncountables: make(map[string]bool),
+	}
+	scan := bufio.NewScanner(in)
+	for lineNum := 1; err == nil && scan.Scan(); lineNum++ {
+		line := scan.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		switch words := strings.Fields(line); len(words) {
+		case 0:
+			// blank line or comment
+		case 1:
+			err = d.addUncountable(words[0])
+		case 2:
+			err = d.addIrregular(words[0], words[1])
+		default:
+			err = fmt.Errorf("expected one or two words, have %d", len(words))
+		}
+		if err != nil {
+			err = fmt.Errorf("%d: %w", lineNum, err)
+		}
+	}
+	if err == nil {
+		err = scan.Err()
+	}
+	if err == nil {
+		ret = d
+	}
+	return
+}
+
+func (d *dictionary) addUncountable(word string) (err error) {
+	if e := checkWord(word); e != nil {
+		err = e
+	} else if _, ok := d.plurals[word]; ok {
+		err = fmt.Errorf("%q is both irregular and uncountable", word)
+	} else {
+		d.uncountables[word] = true
+	}
+	return
+}
+
+func (d *dictionary) addIrregular(singular, plural string) (err error) {
+	if e := checkWord(singular); e != nil {
+		err = e
+	} else if e := checkWord(plural); e != nil {
+		err = e
+	} else if d.uncountables[singular] {
+		err = fmt.Errorf("%q is both irregular and uncountable", singular)
+	} else if p, ok := d.plurals[singular]; ok && p != plural {
+		err = fmt.Errorf("%q has plurals %q and %q", singular, p, plural)
+	} else if s, ok := d.singulars[plural]; ok && s != singular {
+		err = fmt.Errorf("%q has singulars %q and %q", plural, s, singular)
+	} else {
+		d.plurals[singular] = plural
+		d.singulars[plural] = singular
+	}
+	return
+}
+
+// words should be lowercase letters, with the occasional dash.
+func checkWord(word string) (err error) {
+	for _, c := range word {
+		if !unicode.IsLower(c) && c != '-' {
+			err = fmt.Errorf("unexpected %q in %q", c, word)
+			break
+		}
+	}
+	return
+}
+
+func (d *dictionary) write(out io.Writer) error {
+	w := bufio.NewWriter(out)
+	fmt.Fprintln(w, "# generated by internal/gendict from data/words.txt; DO NOT EDIT.")
+	for _, singular := range sortedKeys(d.plurals) {
+		fmt.Fprintln(w, singular, d.plurals[singular])
+	}
+	for _, word := range sortedKeys(d.uncountables) {
+		fmt.Fprintln(w, word)
+	}
+	return w.Flush()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,132 @@
+package inflect
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+)
+
+//go:embed data/nouns.txt
+var nounList string
+
+var nounsOnce sync.Once
+var nounSet map[string]bool
+
+// the common nouns from data/nouns.txt; parsed the first time they are needed.
+// the returned map is shared, and shouldn't be modified.
+func commonNouns() map[string]bool {
+	nounsOnce.Do(func() {
+		nounSet = make(map[string]bool)
+		for _, line := range strings.Split(nounList, "\n") {
+			if word := strings.TrimSpace(line); len(word) > 0 && !strings.HasPrefix(word, "#") {
+				nounSet[word] = true
+			}
+		}
+	})
+	return nounSet
+}
+
+// endings of plural words, and the singular endings they might have come from.
+var pluralEndings = []struct {
+	plural    string
+	singulars []string
+}{
+	{"ves", []string{"f", "fe"}},
+	{"ies", []string{"y", "ie"}},
+	{"ices", []string{"ex", "ix"}},
+	{"zes", []string{"z"}},
+	{"es", []string{"", "is"}},
+	{"men", []string{"man"}},
+	{"eaux", []string{"eau"}},
+	{"ae", []string{"a"}},
+	{"i", []string{"us"}},
+	{"a", []string{"um", "on"}},
+}
+
+// add the singular form of a noun to help Singularize choose between ambiguous endings.
+// for example, "leave" picks "leaves" -> "leave" over the suffix rule "leaves" -> "leaf".
+func (rs *Ruleset) AddNoun(singular string) {
+	if rs.nouns == nil {
+		rs.nouns = make(map[string]bool)
+	}
+	rs.nouns[rs.toLower(singular)] = true
+}
+
+func (rs *Ruleset) isNoun(word string) (okay bool) {
+	if okay = rs.nouns[word]; !okay {
+		for _, nouns := range rs.lexicons {
+			if nouns[word] {
+				okay = true
+				break
+			}
+		}
+	}
+	return
+}
+
+// use the known nouns to pick between the possible singular forms of a word.
+// fallback is what the suffix rules decided; it comes first when an exact rule matched the word,
+// and otherwise only appears when it's a known noun, or when there are no known nouns to choose from.
+func (rs *Ruleset) guessSingulars(word, fallback string) (ret []string) {
+	prefix, last := lastWord(word)
+	lower := rs.toLower(last)
+	if rs.isUncountable(word) {
+		ret = []string{fallback}
+	} else {
+		var known []string
+		for _, guess := range singularGuesses(lower, rs.singulars) {
+			if rs.isNoun(guess) {
+				if p := prefix + rs.matchCase(last, guess); !contains(known, p) {
+					known = append(known, p)
+				}
+			}
+		}
+		if rule, ok := findRule(rs.singulars, lower); ok && rule.exact {
+			ret = []string{fallback}
+			for _, p := range known {
+				if p != fallback {
+					ret = append(ret, p)
+				}
+			}
+		} else if len(known) > 0 {
+			ret = known
+		} else {
+			ret = []string{fallback}
+		}
+	}
+	return
+}
+
+// every possible singular form of a lowercase plural, in order of preference:
+// the word itself, the word without its "s", what the suffix rules suggest, then other common endings.
+func singularGuesses(plural string, rules []Rule) (ret []string) {
+	ret = append(ret, plural)
+	if trimmed := strings.TrimSuffix(plural, "s"); len(trimmed) < len(plural) {
+		ret = append(ret, trimmed)
+	}
+	if p, _ := find(rules, plural); len(p) > 0 {
+		ret = append(ret, p)
+	}
+	for _, end := range pluralEndings {
+		if trimmed := strings.TrimSuffix(plural, end.plural); len(trimmed) < len(plural) {
+			for _, sub := range end.singulars {
+				ret = append(ret, trimmed+sub)
+			}
+		}
+	}
+	return
+}
+
+func contains(list []string, str string) (okay bool) {
+	for _, el := range list {
+		if el == str {
+			okay = true
+			break
+		}
+	}
+	return
+}
+
+func AddNoun(singular string) {
+	Rules.AddNoun(singular)
+}
@@ -0,0 +1,83 @@
+package inflect
+
+import (
+	"reflect"
+	"testing"
+)
+
+var AmbiguousPluralToSingular = map[string]string{
+	"curves":      "curve",
+	"caves":       "cave",
+	"cafes":       "cafe",
+	"gases":       "gas",
+	"toes":        "toe",
+	"heroes":      "hero",
+	"gloves":      "glove",
+	"olives":      "olive",
+	"knives":      "knife",
+	"thieves":     "thief",
+	"leaves":      "leaf",
+	"lenses":      "lens",
+	"glass":       "glass",
+	"cacti":       "cactus",
+	"phenomena":   "phenomenon",
+	"criteria":    "criterion",
+	"Curves":      "Curve",
+	"road_curves": "road_curve",
+	"RoadCurves":  "RoadCurve",
+	"axes":        "axis",
+	"saves":       "safe",
+	"pies":        "pie",
+	"ties":        "tie",
+	"dies":        "die",
+	"lies":        "lie",
+	"bodies":      "body",
+	"hooves":      "hoof",
+	"virus":       "virus",
+	"status":      "status",
+}
+
+func TestSingularizeAmbiguous(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for plural, singular := range AmbiguousPluralToSingular {
+		if want, got := singular, rs.Singularize(plural); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+func TestSingularCandidates(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for plural, candidates := range map[string][]string{
+		"caves":           {"cave", "cafe"},
+		"axes":            {"axis", "axe"},
+		"sheep":           {"sheep"},
+		"curves":          {"curve"},
+		"virus":           {"virus"},
+		"status":          {"status"},
+		"octopus":         {"octopus"},
+		"hooves":          {"hoof"},
+		"widgets":         {"widget"},
+		"":                nil,
+		"bills of lading": {"bill of lading"},
+	} {
+		if want, got := candidates, rs.SingularCandidates(plural); !reflect.DeepEqual(got, want) {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+func TestAddNoun(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	if want, got := "leaf", rs.Singularize("leaves"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	rs.AddNoun("leave")
+	if want, got := "leave", rs.Singularize("leaves"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	// doesn't change the shared list of nouns
+	if other := AddDefaultRules(&Ruleset{}); other.isNoun("leave") {
+		t.Error("added noun leaked into another ruleset")
+	}
+}
@@ -0,0 +1,194 @@
+package inflect
+
+import (
+	"math/big"
+	"strings"
+)
+
+// NumberStyle changes how numbers are spelled out.
+// the zero value is American English: "one hundred five", "minus one".
+type NumberStyle struct {
+	And      bool   // British English: "one hundred and five", "one thousand and one"
+	Negative string // the word for negative numbers; "minus" when empty.
+}
+
+// AmericanNumbers: "one hundred five"; the same as the zero value.
+func AmericanNumbers() NumberStyle {
+	return NumberStyle{}
+}
+
+// BritishNumbers: "one hundred and five"
+func BritishNumbers() NumberStyle {
+	return NumberStyle{And: true}
+}
+
+// SetNumberStyle changes how NumberToWords and OrdinalWords spell numbers.
+func (rs *Ruleset) SetNumberStyle(style NumberStyle) {
+	rs.numberStyle = style
+}
+
+var smallNumbers = []string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+	"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+}
+
+var tens = []string{
+	"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety",
+}
+
+// short scale names for each group of three digits.
+// larger numbers repeat the last: a thousand vigintillion.
+var scales = []string{
+	"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+	"sextillion", "septillion", "octillion", "nonillion", "decillion", "undecillion",
+	"duodecillion", "tredecillion", "quattuordecillion", "quindecillion", "sexdecillion",
+	"septendecillion", "octodecillion", "novemdecillion", "vigintillion",
+}
+
+// the ordinals of cardinal numbers which don't simply add "th"
+var irregularOrdinals = map[string]string{
+	"one":    "first",
+	"two":    "second",
+	"three":  "third",
+	"five":   "fifth",
+	"eight":  "eighth",
+	"nine":   "ninth",
+	"twelve": "twelfth",
+}
+
+// NumberToWords spells out a number: 1031 -> "one thousand thirty-one", -15 -> "minus fifteen".
+// see SetNumberStyle.
+func (rs *Ruleset) NumberToWords(n int64) string {
+	// work with the unsigned value so that math.MinInt64 can be negated.
+	u := uint64(n)
+	if n < 0 {
+		u = -u
+	}
+	var groups []int
+	for ; u > 0; u /= 1000 {
+		groups = append(groups, int(u%1000))
+	}
+	return rs.spellNumber(groups, n < 0)
+}
+
+// BigNumberToWords spells out numbers of any size: 10^66 -> "one thousand vigintillion".
+// returns an empty string for nil.
+func (rs *Ruleset) BigNumberToWords(n *big.Int) (ret string) {
+	if n != nil {
+		var groups []int
+		u, thousand, group := new(big.Int).Abs(n), big.NewInt(1000), new(big.Int)
+		for u.Sign() > 0 {
+			u.DivMod(u, thousand, group)
+			groups = append(groups, int(group.Int64()))
+		}
+		ret = rs.spellNumber(groups, n.Sign() < 0)
+	}
+	return
+}
+
+// OrdinalWords spells out an ordinal number: 21 -> "twenty-first", 100 -> "one hundredth".
+func (rs *Ruleset) OrdinalWords(n int64) string {
+	return ordinalWords(rs.NumberToWords(n))
+}
+
+// BigOrdinalWords spells out ordinal numbers of any size; returns an empty string for nil.
+func (rs *Ruleset) BigOrdinalWords(n *big.Int) (ret string) {
+	if n != nil {
+		ret = ordinalWords(rs.BigNumberToWords(n))
+	}
+	return
+}
+
+// "twenty-one" -> "twenty-first"
+func ordinalWords(cardinal string) (ret string) {
+	i := strings.LastIndexAny(cardinal, " -") + 1
+	prefix, last := cardinal[:i], cardinal[i:]
+	if ord, ok := irregularOrdinals[last]; ok {
+		ret = prefix + ord
+	} else if strings.HasSuffix(last, "y") {
+		ret = prefix + last[:len(last)-1] + "ieth"
+	} else {
+		ret = cardinal + "th"
+	}
+	return
+}
+
+// groups of three digits, least significant first.
+func (rs *Ruleset) spellNumber(groups []int, negative bool) (ret string) {
+	if len(groups) == 0 {
+		ret = smallNumbers[0]
+	} else {
+		ret = rs.spellGroups(groups, false)
+		if negative {
+			minus := rs.numberStyle.Negative
+			if len(minus) == 0 {
+				minus = "minus"
+			}
+			ret = minus + " " + ret
+		}
+	}
+	return
+}
+
+// higher is true when there are non-zero groups above these.
+func (rs *Ruleset) spellGroups(groups []int, higher bool) string {
+	var words []string
+	if top := len(scales) - 1; len(groups) > top+1 {
+		words = append(words, rs.spellGroups(groups[top:], false)+" "+scales[top])
+		groups, higher = groups[:top], true
+	}
+	and := rs.numberStyle.And
+	for i := len(groups) - 1; i >= 0; i-- {
+		if group := groups[i]; group > 0 {
+			w := spellHundreds(group, and)
+			if i > 0 {
+				w += " " + scales[i]
+			} else if and && group < 100 && (higher || len(words) > 0) {
+				w = "and " + w // "one thousand and one"
+			}
+			words = append(words, w)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// 1 to 999 -> "nine hundred ninety-nine", or "nine hundred and ninety-nine"
+func spellHundreds(n int, and bool) string {
+	var words []string
+	if h := n / 100; h > 0 {
+		words = append(words, smallNumbers[h]+" hundred")
+		if and && n%100 > 0 {
+			words = append(words, "and")
+		}
+	}
+	if n %= 100; n >= 20 {
+		if ones := n % 10; ones > 0 {
+			words = append(words, tens[n/10]+"-"+smallNumbers[ones])
+		} else {
+			words = append(words, tens[n/10])
+		}
+	} else if n > 0 {
+		words = append(words, smallNumbers[n])
+	}
+	return strings.Join(words, " ")
+}
+
+func SetNumberStyle(style NumberStyle) {
+	Rules.SetNumberStyle(style)
+}
+
+func NumberToWords(n int64) string {
+	return Rules.NumberToWords(n)
+}
+
+func BigNumberToWords(n *big.Int) string {
+	return Rules.BigNumberToWords(n)
+}
+
+func OrdinalWords(n int64) string {
+	return Rules.OrdinalWords(n)
+}
+
+func BigOrdinalWords(n *big.Int) string {
+	return Rules.BigOrdinalWords(n)
+}
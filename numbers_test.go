@@ -0,0 +1,112 @@
+package inflect
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestNumberToWords(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for n, want := range map[int64]string{
+		0:             "zero",
+		7:             "seven",
+		40:            "forty",
+		100:           "one hundred",
+		105:           "one hundred five",
+		1031:          "one thousand thirty-one",
+		1000000:       "one million",
+		-15:           "minus fifteen",
+		math.MaxInt64: "nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred seven",
+		math.MinInt64: "minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight",
+	} {
+		if got := rs.NumberToWords(n); got != want {
+			t.Error(n, "want", want, "got", got)
+		}
+	}
+}
+
+func TestBritishNumbers(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	rs.SetNumberStyle(BritishNumbers())
+	for n, want := range map[int64]string{
+		5:       "five",
+		100:     "one hundred",
+		105:     "one hundred and five",
+		1001:    "one thousand and one",
+		1031:    "one thousand and thirty-one",
+		1100:    "one thousand one hundred",
+		105001:  "one hundred and five thousand and one",
+		2000000: "two million",
+	} {
+		if got := rs.NumberToWords(n); got != want {
+			t.Error(n, "want", want, "got", got)
+		}
+	}
+	rs.SetNumberStyle(NumberStyle{Negative: "negative"})
+	if want, got := "negative first", rs.OrdinalWords(-1); got != want {
+		t.Error("want", want, "got", got)
+	}
+	rs.SetNumberStyle(AmericanNumbers())
+	if want, got := "one hundred five", rs.NumberToWords(105); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
+
+func TestOrdinalWords(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for n, want := range map[int64]string{
+		0:       "zeroth",
+		1:       "first",
+		2:       "second",
+		3:       "third",
+		4:       "fourth",
+		5:       "fifth",
+		8:       "eighth",
+		9:       "ninth",
+		12:      "twelfth",
+		20:      "twentieth",
+		21:      "twenty-first",
+		100:     "one hundredth",
+		1031:    "one thousand thirty-first",
+		1000000: "one millionth",
+		-3:      "minus third",
+	} {
+		if got := rs.OrdinalWords(n); got != want {
+			t.Error(n, "want", want, "got", got)
+		}
+	}
+}
+
+func TestBigNumberToWords(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	exp := func(n int64) *big.Int {
+		return new(big.Int).Exp(big.NewInt(10), big.NewInt(n), nil)
+	}
+	for _, test := range []struct {
+		n    *big.Int
+		want string
+	}{
+		{big.NewInt(0), "zero"},
+		{big.NewInt(-1031), "minus one thousand thirty-one"},
+		{exp(21), "one sextillion"},
+		{exp(63), "one vigintillion"},
+		{exp(66), "one thousand vigintillion"},
+		{new(big.Int).Add(exp(66), exp(63)), "one thousand one vigintillion"},
+		{new(big.Int).Add(exp(63), big.NewInt(5)), "one vigintillion five"},
+		{exp(126), "one vigintillion vigintillion"},
+	} {
+		if got := rs.BigNumberToWords(test.n); got != test.want {
+			t.Error(test.n, "want", test.want, "got", got)
+		}
+	}
+	if want, got := "one vigintillionth", rs.BigOrdinalWords(exp(63)); got != want {
+		t.Error("want", want, "got", got)
+	}
+	if want, got := "", rs.BigNumberToWords(nil); got != want {
+		t.Error("want", want, "got", got)
+	}
+	if want, got := "", rs.BigOrdinalWords(nil); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
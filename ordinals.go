@@ -0,0 +1,133 @@
+package inflect
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Integer matches every built-in integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// OrdinalFormat picks how Ordinalize writes the suffix.
+type OrdinalFormat int
+
+const (
+	// PlainOrdinals: "1st"
+	PlainOrdinals OrdinalFormat = iota
+	// HTMLOrdinals: "1<sup>st</sup>"
+	HTMLOrdinals
+	// SuperscriptOrdinals use unicode modifier letters: "1ˢᵗ"
+	SuperscriptOrdinals
+)
+
+var superscripts = strings.NewReplacer("s", "ˢ", "t", "ᵗ", "n", "ⁿ", "d", "ᵈ", "r", "ʳ", "h", "ʰ")
+
+// the reverse of superscripts, for Deordinalize.
+var unsuperscripts = strings.NewReplacer("ˢ", "s", "ᵗ", "t", "ⁿ", "n", "ᵈ", "d", "ʳ", "r", "ʰ", "h")
+
+// SetOrdinalFormat changes how Ordinalize, OrdinalizeInt, and OrdinalizeBig write the suffix.
+func (rs *Ruleset) SetOrdinalFormat(format OrdinalFormat) {
+	rs.ordinalFormat = format
+}
+
+// OrdinalizeBig: the ordinal of numbers of any size: 10^20 -> "100000000000000000000th".
+// returns an empty string for nil.
+func (rs *Ruleset) OrdinalizeBig(n *big.Int) (ret string) {
+	if n != nil {
+		ret = rs.Ordinalize(n.String())
+	}
+	return
+}
+
+// OrdinalizeInt: the ordinal of any integer type using the default Rules: int64(-22) -> "-22nd".
+// for other rulesets, pass the formatted number to Ruleset.Ordinalize.
+func OrdinalizeInt[T Integer](n T) string {
+	return Rules.Ordinalize(formatInteger(n))
+}
+
+// works for the full range of both signed and unsigned types.
+func formatInteger[T Integer](n T) (ret string) {
+	if n < 0 {
+		ret = strconv.FormatInt(int64(n), 10)
+	} else {
+		ret = strconv.FormatUint(uint64(n), 10)
+	}
+	return
+}
+
+// the suffix for a string of digits, in the ruleset's format.
+func (rs *Ruleset) ordinalSuffix(digits string) (ret string) {
+	ret = ordinalEnding(digits)
+	switch rs.ordinalFormat {
+	case HTMLOrdinals:
+		ret = "<sup>" + ret + "</sup>"
+	case SuperscriptOrdinals:
+		ret = superscripts.Replace(ret)
+	}
+	return
+}
+
+// "1" -> "st", "11" -> "th", "22" -> "nd"
+func ordinalEnding(digits string) (ret string) {
+	var tens, ones byte
+	if n := len(digits); n > 0 {
+		ones = digits[n-1]
+		if n > 1 {
+			tens = digits[n-2]
+		}
+	}
+	switch {
+	case tens == '1':
+		ret = "th"
+	case ones == '1':
+		ret = "st"
+	case ones == '2':
+		ret = "nd"
+	case ones == '3':
+		ret = "rd"
+	default:
+		ret = "th"
+	}
+	return
+}
+
+// returns just the digits of a whole number with an optional sign, and optional separators between groups of three:
+// "-1,001", "1 000 000", "1_000".
+func groupedDigits(s string) (ret string, okay bool) {
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		s = s[1:]
+	}
+	var digits strings.Builder
+	var sep rune  // the separator used by this number
+	var group int // the count of digits since the last separator
+	for _, c := range s {
+		if c >= '0' && c <= '9' {
+			digits.WriteByte(byte(c))
+			group++
+		} else if !isDigitSeparator(c) || group == 0 || (sep == 0 && group > 3) || (sep != 0 && (c != sep || group != 3)) {
+			return // the leading group has one to three digits, and every other group has three.
+		} else {
+			sep, group = c, 0
+		}
+	}
+	if group > 0 && (sep == 0 || group == 3) {
+		ret, okay = digits.String(), true
+	}
+	return
+}
+
+func isDigitSeparator(c rune) bool {
+	return c == ',' || c == '_' || c == '\'' || c == ' ' || c == '\u00a0' || c == '\u202f'
+}
+
+func SetOrdinalFormat(format OrdinalFormat) {
+	Rules.SetOrdinalFormat(format)
+}
+
+func OrdinalizeBig(n *big.Int) string {
+	return Rules.OrdinalizeBig(n)
+}
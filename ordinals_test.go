@@ -0,0 +1,71 @@
+package inflect
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestOrdinalizeInt(t *testing.T) {
+	type Place uint16
+	for want, got := range map[string]string{
+		"1st":                     OrdinalizeInt(1),
+		"-128th":                  OrdinalizeInt(int8(math.MinInt8)),
+		"255th":                   OrdinalizeInt(uint8(math.MaxUint8)),
+		"-9223372036854775808th":  OrdinalizeInt(int64(math.MinInt64)),
+		"9223372036854775807th":   OrdinalizeInt(int64(math.MaxInt64)),
+		"18446744073709551615th":  OrdinalizeInt(uint64(math.MaxUint64)),
+		"22nd":                    OrdinalizeInt(Place(22)),
+		"100000000000000000001st": OrdinalizeBig(new(big.Int).Add(new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil), big.NewInt(1))),
+		"":                        OrdinalizeBig(nil),
+	} {
+		if got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+func TestOrdinalizeGroups(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for str, want := range map[string]string{
+		"1,001":                "1,001st",
+		"-1,012":               "-1,012th",
+		"1 000 000":            "1 000 000th",
+		"1_002":                "1_002nd",
+		" 21 ":                 " 21st ",
+		"99999999999999999999": "99999999999999999999th",
+		"1,00":                 "1,00",
+		"1000,000":             "1000,000",
+		"1,000_000":            "1,000_000",
+		",1":                   ",1",
+		"1,":                   "1,",
+		"1.5":                  "1.5",
+		"abc":                  "abc",
+		"":                     "",
+		"-":                    "-",
+	} {
+		if got := rs.Ordinalize(str); got != want {
+			t.Errorf("%q want %q got %q", str, want, got)
+		}
+	}
+}
+
+func TestOrdinalFormat(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	rs.SetOrdinalFormat(HTMLOrdinals)
+	if want, got := "1<sup>st</sup>", rs.Ordinalize("1"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	rs.SetOrdinalFormat(SuperscriptOrdinals)
+	for str, want := range map[string]string{
+		"1":  "1ˢᵗ",
+		"2":  "2ⁿᵈ",
+		"3":  "3ʳᵈ",
+		"4":  "4ᵗʰ",
+		"xy": "xy",
+	} {
+		if got := rs.Ordinalize(str); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package inflect
+
+// vocabulary of software and computing.
+var technicalIrregulars = irregulars{
+	{"automaton", "automata"},
+	{"beta", "betas"},
+	{"corpus", "corpora"},
+	{"criterion", "criteria"},
+	{"daemon", "daemons"},
+	{"delta", "deltas"},
+	{"ellipsis", "ellipses"},
+	{"focus", "focuses"},
+	{"formula", "formulas"},
+	{"index", "indexes"},
+	{"lens", "lenses"},
+	{"matrix", "matrices"},
+	{"mouse", "mice"},
+	{"nexus", "nexuses"},
+	{"polyhedron", "polyhedra"},
+	{"quota", "quotas"},
+	{"radix", "radices"},
+	{"schema", "schemas"},
+	{"simplex", "simplices"},
+	{"vertex", "vertices"},
+	{"virus", "viruses"},
+}
+
+var technicalUncountables = []string{
+	"analytics", "bandwidth", "documentation", "feedback", "firmware",
+	"hardware", "infrastructure", "malware", "metadata", "middleware",
+	"spyware", "storage", "software", "telemetry", "throughput", "traffic",
+}
+
+// vocabulary of anatomy and medicine.
+var medicalIrregulars = irregulars{
+	{"alveolus", "alveoli"},
+	{"anastomosis", "anastomoses"},
+	{"apex", "apices"},
+	{"appendix", "appendices"},
+	{"atrium", "atria"},
+	{"bacillus", "bacilli"},
+	{"bacterium", "bacteria"},
+	{"bronchus", "bronchi"},
+	{"bulla", "bullae"},
+	{"calculus", "calculi"},
+	{"cervix", "cervices"},
+	{"cortex", "cortices"},
+	{"diverticulum", "diverticula"},
+	{"embolus", "emboli"},
+	{"femur", "femora"},
+	{"fetus", "fetuses"},
+	{"fibula", "fibulae"},
+	{"foramen", "foramina"},
+	{"fungus", "fungi"},
+	{"ganglion", "ganglia"},
+	{"gyrus", "gyri"},
+	{"hilum", "hila"},
+	{"humerus", "humeri"},
+	{"labium", "labia"},
+	{"lamina", "laminae"},
+	{"larynx", "larynges"},
+	{"lumen", "lumina"},
+	{"macula", "maculae"},
+	{"meniscus", "menisci"},
+	{"metastasis", "metastases"},
+	{"nucleus", "nuclei"},
+	{"omentum", "omenta"},
+	{"ovum", "ova"},
+	{"papilla", "papillae"},
+	{"patella", "patellae"},
+	{"phalanx", "phalanges"},
+	{"pharynx", "pharynges"},
+	{"pleura", "pleurae"},
+	{"radius", "radii"},
+	{"scapula", "scapulae"},
+	{"septum", "septa"},
+	{"serum", "sera"},
+	{"sinus", "sinuses"},
+	{"stimulus", "stimuli"},
+	{"stoma", "stomata"},
+	{"sulcus", "sulci"},
+	{"thrombus", "thrombi"},
+	{"tibia", "tibiae"},
+	{"ulna", "ulnae"},
+	{"uterus", "uteri"},
+	{"vertebra", "vertebrae"},
+	{"villus", "villi"},
+	{"virus", "viruses"},
+}
+
+var medicalUncountables = []string{
+	"aftercare", "anemia", "anesthesia", "arthritis", "asthma",
+	"chemotherapy", "cholera", "dementia", "diabetes", "edema",
+	"healthcare", "herpes", "hypertension", "influenza", "insomnia",
+	"insulin", "jaundice", "leprosy", "malaria", "measles", "mucus",
+	"mumps", "nausea", "physiotherapy", "plasma", "pneumonia", "pus",
+	"rabies", "radiotherapy", "rickets", "saliva", "scabies", "sepsis",
+	"shingles", "tuberculosis", "urine",
+}
+
+// vocabulary of law and contracts.
+var legalIrregulars = irregulars{
+	{"addendum", "addenda"},
+	{"appendix", "appendices"},
+	{"erratum", "errata"},
+	{"memorandum", "memoranda"},
+	{"proviso", "provisos"},
+}
+
+var legalPostpositives = []string{
+	"apparent", "patent", "politic", "presumptive", "public",
+}
+
+var legalUncountables = []string{
+	"alimony", "bona fides", "counsel", "custody", "estoppel", "evidence",
+	"habeas corpus", "hearsay", "jurisprudence", "legislation", "litigation",
+	"mens rea", "perjury", "probate", "res judicata",
+}
+
+// AddTechnicalRules for software and computing to the passed rules:
+// "schema" -> "schemas", "index" -> "indexes", "quota" -> "quotas", "metadata" -> "metadata".
+// Returns the same ruleset for easier statement chaining
+func AddTechnicalRules(rs *Ruleset) *Ruleset {
+	return rs.addPack(technicalIrregulars, technicalUncountables)
+}
+
+// AddMedicalRules for anatomy and medicine to the passed rules:
+// "vertebra" -> "vertebrae", "appendix" -> "appendices", "bronchus" -> "bronchi".
+// Returns the same ruleset for easier statement chaining
+func AddMedicalRules(rs *Ruleset) *Ruleset {
+	return rs.addPack(medicalIrregulars, medicalUncountables)
+}
+
+// AddLegalRules for law and contracts to the passed rules:
+// "addendum" -> "addenda", "notary public" -> "notaries public", "counsel" -> "counsel".
+// Returns the same ruleset for easier statement chaining
+func AddLegalRules(rs *Ruleset) *Ruleset {
+	for _, word := range legalPostpositives {
+		rs.AddPostpositive(word)
+	}
+	return rs.addPack(legalIrregulars, legalUncountables)
+}
+
+// packs only change whole words, or the last word of compounds,
+// so that "ovum" -> "ova" doesn't also turn "supernova" into a plural.
+func (rs *Ruleset) addPack(list irregulars, uncountables []string) *Ruleset {
+	for _, pair := range list {
+		rs.AddIrregularExact(pair[0], pair[1])
+	}
+	for _, word := range uncountables {
+		rs.AddUncountable(word)
+	}
+	return rs
+}
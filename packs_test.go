@@ -0,0 +1,80 @@
+package inflect
+
+import (
+	"testing"
+)
+
+var TechnicalSingularToPlural = map[string]string{
+	"schema":        "schemas",
+	"db_schema":     "db_schemas",
+	"index":         "indexes",
+	"quota":         "quotas",
+	"ResourceQuota": "ResourceQuotas",
+	"delta":         "deltas",
+	"vertex":        "vertices",
+	"virus":         "viruses",
+	"metadata":      "metadata",
+	"firmware":      "firmware",
+	"corpus":        "corpora",
+	"user":          "users",
+}
+
+var MedicalSingularToPlural = map[string]string{
+	"vertebra":  "vertebrae",
+	"appendix":  "appendices",
+	"bronchus":  "bronchi",
+	"bacterium": "bacteria",
+	"ovum":      "ova",
+	"supernova": "supernovas",
+	"serum":     "sera",
+	"camera":    "cameras",
+	"diabetes":  "diabetes",
+	"patient":   "patients",
+}
+
+var LegalSingularToPlural = map[string]string{
+	"attorney general":      "attorneys general",
+	"notary public":         "notaries public",
+	"heir apparent":         "heirs apparent",
+	"letter patent":         "letters patent",
+	"power of attorney":     "powers of attorney",
+	"addendum":              "addenda",
+	"memorandum":            "memoranda",
+	"counsel":               "counsel",
+	"habeas corpus":         "habeas corpus",
+	"writ of habeas corpus": "writs of habeas corpus",
+	"contract":              "contracts",
+}
+
+func testPack(t *testing.T, rs *Ruleset, corpus map[string]string) {
+	for singular, plural := range corpus {
+		if want, got := plural, rs.Pluralize(singular); got != want {
+			t.Error("want", want, "got", got)
+		}
+		if want, got := plural, rs.Pluralize(plural); got != want {
+			t.Error("want", want, "got", got)
+		}
+		if want, got := singular, rs.Singularize(plural); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+func TestTechnicalRules(t *testing.T) {
+	testPack(t, AddTechnicalRules(AddDefaultRules(&Ruleset{})), TechnicalSingularToPlural)
+}
+
+func TestMedicalRules(t *testing.T) {
+	testPack(t, AddMedicalRules(AddDefaultRules(&Ruleset{})), MedicalSingularToPlural)
+}
+
+func TestLegalRules(t *testing.T) {
+	testPack(t, AddLegalRules(AddDefaultRules(&Ruleset{})), LegalSingularToPlural)
+}
+
+func TestComposedPacks(t *testing.T) {
+	rs := AddLegalRules(AddMedicalRules(AddTechnicalRules(AddDefaultRules(&Ruleset{}))))
+	testPack(t, rs, TechnicalSingularToPlural)
+	testPack(t, rs, LegalSingularToPlural)
+	testPack(t, rs, MedicalSingularToPlural)
+}
@@ -0,0 +1,265 @@
+package inflect
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// errors returned by WordsToNumber and Deordinalize, wrapped in a *NumberError.
+var (
+	ErrNoNumber      = errors.New("no number")
+	ErrUnknownWord   = errors.New("unknown number word")
+	ErrMisplacedWord = errors.New("misplaced number word")
+	ErrOutOfRange    = errors.New("number out of range")
+	ErrNotOrdinal    = errors.New("not an ordinal")
+	ErrWrongSuffix   = errors.New("wrong ordinal suffix")
+)
+
+// NumberError records a failure to parse a number.
+type NumberError struct {
+	Input string // the text passed to WordsToNumber or Deordinalize
+	Word  string // the word which caused the error, if any.
+	Err   error  // one of the Err values above
+}
+
+func (e *NumberError) Error() (ret string) {
+	ret = "inflect: parsing " + strconv.Quote(e.Input) + ": " + e.Err.Error()
+	if len(e.Word) > 0 {
+		ret += " " + strconv.Quote(e.Word)
+	}
+	return
+}
+
+func (e *NumberError) Unwrap() error {
+	return e.Err
+}
+
+// WordsToNumber reads a spelled out number: "twenty-one" -> 21, "a hundred and five" -> 105, "minus three" -> -3.
+// it accepts both American and British styles, and plain digits: "1,001" -> 1001.
+func (rs *Ruleset) WordsToNumber(s string) (int64, error) {
+	return rs.parseNumber(s, false)
+}
+
+// Deordinalize reads an ordinal number: "21st" -> 21, "first" -> 1, "twenty-first" -> 21, "one hundredth" -> 100.
+// the inverse of Ordinalize and OrdinalWords; it reads every OrdinalFormat: "21<sup>st</sup>", "21ˢᵗ".
+func (rs *Ruleset) Deordinalize(s string) (int64, error) {
+	return rs.parseNumber(s, true)
+}
+
+func (rs *Ruleset) parseNumber(s string, ordinal bool) (int64, error) {
+	fail := func(word string, e error) (int64, error) {
+		return 0, &NumberError{Input: s, Word: word, Err: e}
+	}
+	text := strings.TrimSpace(s)
+	if len(text) == 0 {
+		return fail("", ErrNoNumber)
+	}
+	if first, _ := utf8.DecodeRuneInString(text); first == '-' || first == '+' || unicode.IsDigit(first) {
+		return rs.parseDigits(s, text, ordinal)
+	}
+	words := strings.FieldsFunc(rs.toLower(text), func(c rune) bool {
+		return c == ' ' || c == '-' || c == ',' || unicode.IsSpace(c)
+	})
+	if len(words) == 0 {
+		return fail(text, ErrUnknownWord)
+	}
+	negative := false
+	if minus := rs.numberStyle.Negative; words[0] == "minus" || words[0] == "negative" || words[0] == minus {
+		negative, words = true, words[1:]
+		if len(words) == 0 {
+			return fail("", ErrNoNumber)
+		}
+	}
+	if ordinal {
+		last := len(words) - 1
+		if cardinal, ok := cardinalWord(words[last]); !ok {
+			return fail(words[last], ErrNotOrdinal)
+		} else {
+			words = append(words[:last:last], cardinal)
+		}
+	}
+	n, bad, e := parseWords(words)
+	if e != nil {
+		return fail(bad, e)
+	}
+	if negative {
+		n.Neg(n)
+	}
+	if !n.IsInt64() {
+		return fail("", ErrOutOfRange)
+	}
+	return n.Int64(), nil
+}
+
+// "21", "-1,001", or ordinals like "21st"
+func (rs *Ruleset) parseDigits(s, text string, ordinal bool) (ret int64, err error) {
+	digits, suffix := text, ""
+	if ordinal {
+		text = plainOrdinal(text)
+		end := len(text)
+		for end > 0 && unicode.IsLetter(rune(text[end-1])) {
+			end--
+		}
+		digits, suffix = text[:end], strings.ToLower(text[end:])
+	}
+	plain, ok := groupedDigits(digits)
+	if ok && strings.HasPrefix(digits, "-") {
+		plain = "-" + plain
+	}
+	if n, e := strconv.ParseInt(plain, 10, 64); !ok || e != nil {
+		if errors.Is(e, strconv.ErrRange) {
+			err = &NumberError{Input: s, Err: ErrOutOfRange}
+		} else {
+			err = &NumberError{Input: s, Word: text, Err: ErrUnknownWord}
+		}
+	} else if ordinal && len(suffix) == 0 {
+		err = &NumberError{Input: s, Word: text, Err: ErrNotOrdinal}
+	} else if ordinal && ordinalEnding(plain) != suffix {
+		err = &NumberError{Input: s, Word: suffix, Err: ErrWrongSuffix}
+	} else {
+		ret = n
+	}
+	return
+}
+
+// "1<sup>st</sup>" and "1ˢᵗ" -> "1st"
+func plainOrdinal(text string) (ret string) {
+	if inner := strings.TrimSuffix(text, "</sup>"); len(inner) < len(text) {
+		if i := strings.LastIndex(inner, "<sup>"); i >= 0 {
+			ret = inner[:i] + inner[i+len("<sup>"):]
+		} else {
+			ret = text
+		}
+	} else {
+		ret = unsuperscripts.Replace(text)
+	}
+	return
+}
+
+// "twenty-first" -> "first" -> "one"
+func cardinalWord(ordinal string) (ret string, okay bool) {
+	for cardinal, ord := range irregularOrdinals {
+		if ordinal == ord {
+			return cardinal, true
+		}
+	}
+	if stem := strings.TrimSuffix(ordinal, "ieth"); len(stem) < len(ordinal) {
+		ret, okay = stem+"y", true
+	} else if stem := strings.TrimSuffix(ordinal, "th"); len(stem) < len(ordinal) {
+		ret, okay = stem, true
+	}
+	return
+}
+
+// words of a non-negative number, returning the word which caused any error.
+func parseWords(words []string) (ret *big.Int, bad string, err error) {
+	ret = new(big.Int)
+	var current int64
+	var units, tens, hundreds bool // whether the current group of three digits has each part
+	lastScale := len(scales)
+	for i, w := range words {
+		var next string
+		if i+1 < len(words) {
+			next = words[i+1]
+		}
+		if w == "zero" {
+			if len(words) > 1 {
+				return nil, w, ErrMisplacedWord
+			}
+		} else if w == "and" {
+			// "one hundred and five", "one thousand and five"
+			afterScale := lastScale < len(scales) && current == 0
+			if (smallIndex(next) == 0 && tensIndex(next) == 0) || !(afterScale || (hundreds && !tens && !units)) {
+				return nil, w, ErrMisplacedWord
+			}
+		} else if w == "a" {
+			// "a hundred", "a thousand"
+			if i > 0 || (next != "hundred" && scaleIndex(next) < 0) {
+				return nil, w, ErrMisplacedWord
+			}
+			current, units = 1, true
+		} else if n := smallIndex(w); n > 0 {
+			if units || (tens && n >= 10) {
+				return nil, w, ErrMisplacedWord
+			}
+			current += int64(n)
+			units = true
+		} else if n := tensIndex(w); n > 0 {
+			if units || tens {
+				return nil, w, ErrMisplacedWord
+			}
+			current += int64(n * 10)
+			tens = true
+		} else if w == "hundred" {
+			if hundreds || (current == 0 && i > 0) {
+				return nil, w, ErrMisplacedWord
+			}
+			if current == 0 {
+				current = 1 // "hundred and five"
+			}
+			current *= 100
+			hundreds, tens, units = true, false, false
+		} else if scale := scaleIndex(w); scale > 0 {
+			if scale >= lastScale || (current == 0 && i > 0) {
+				return nil, w, ErrMisplacedWord
+			}
+			if current == 0 {
+				current = 1 // "thousand"
+			}
+			group := new(big.Int).Exp(big.NewInt(1000), big.NewInt(int64(scale)), nil)
+			ret.Add(ret, group.Mul(group, big.NewInt(current)))
+			current, lastScale = 0, scale
+			hundreds, tens, units = false, false, false
+		} else {
+			return nil, w, ErrUnknownWord
+		}
+	}
+	ret.Add(ret, big.NewInt(current))
+	return
+}
+
+// one through nineteen
+func smallIndex(w string) (ret int) {
+	for i, n := range smallNumbers {
+		if i > 0 && n == w {
+			ret = i
+			break
+		}
+	}
+	return
+}
+
+// twenty through ninety, as two through nine
+func tensIndex(w string) (ret int) {
+	for i, n := range tens {
+		if len(n) > 0 && n == w {
+			ret = i
+			break
+		}
+	}
+	return
+}
+
+// thousand is 1, million 2, and so on; -1 if w isn't a scale.
+func scaleIndex(w string) (ret int) {
+	ret = -1
+	for i, n := range scales {
+		if i > 0 && n == w {
+			ret = i
+			break
+		}
+	}
+	return
+}
+
+func WordsToNumber(s string) (int64, error) {
+	return Rules.WordsToNumber(s)
+}
+
+func Deordinalize(s string) (int64, error) {
+	return Rules.Deordinalize(s)
+}
@@ -0,0 +1,127 @@
+package inflect
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestWordsToNumber(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for s, want := range map[string]int64{
+		"zero":                    0,
+		"five":                    5,
+		"twenty-one":              21,
+		"Twenty One":              21,
+		"a hundred and five":      105,
+		"one hundred five":        105,
+		"hundred":                 100,
+		"nineteen hundred":        1900,
+		"one thousand thirty-one": 1031,
+		"one thousand and one":    1001,
+		"two million, three hundred thousand and seven": 2300007,
+		"minus fifteen":    -15,
+		"negative fifteen": -15,
+		"21":               21,
+		" 1,001 ":          1001,
+		"-7":               -7,
+		"nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred seven":       math.MaxInt64,
+		"minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight": math.MinInt64,
+	} {
+		if got, e := rs.WordsToNumber(s); e != nil {
+			t.Error(s, e)
+		} else if got != want {
+			t.Error(s, "want", want, "got", got)
+		}
+	}
+}
+
+func TestDeordinalize(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for s, want := range map[string]int64{
+		"first":                     1,
+		"second":                    2,
+		"Third":                     3,
+		"fifth":                     5,
+		"eighth":                    8,
+		"twelfth":                   12,
+		"twentieth":                 20,
+		"twenty-first":              21,
+		"one hundredth":             100,
+		"one thousand thirty-first": 1031,
+		"minus third":               -3,
+		"1st":                       1,
+		"21st":                      21,
+		"112th":                     112,
+		"1,001st":                   1001,
+		"-2nd":                      -2,
+		"21<sup>st</sup>":           21,
+		"3ʳᵈ":                       3,
+	} {
+		if got, e := rs.Deordinalize(s); e != nil {
+			t.Error(s, e)
+		} else if got != want {
+			t.Error(s, "want", want, "got", got)
+		}
+	}
+}
+
+// every format Ordinalize writes can be read back.
+func TestDeordinalizeFormats(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for _, format := range []OrdinalFormat{PlainOrdinals, HTMLOrdinals, SuperscriptOrdinals} {
+		rs.SetOrdinalFormat(format)
+		for _, n := range []int64{1, 2, 3, 4, 11, 12, 13, 21, 22, 23, 101, 1001, -2} {
+			s := rs.Ordinalize(strconv.FormatInt(n, 10))
+			if got, e := rs.Deordinalize(s); e != nil {
+				t.Error(s, e)
+			} else if got != n {
+				t.Error(s, "want", n, "got", got)
+			}
+		}
+	}
+}
+
+func TestNumberErrors(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for _, test := range []struct {
+		s       string
+		ordinal bool
+		want    error
+		word    string
+	}{
+		{"", false, ErrNoNumber, ""},
+		{"minus", false, ErrNoNumber, ""},
+		{"fivty", false, ErrUnknownWord, "fivty"},
+		{"five five", false, ErrMisplacedWord, "five"},
+		{"twenty eleven", false, ErrMisplacedWord, "eleven"},
+		{"five twenty", false, ErrMisplacedWord, "twenty"},
+		{"one hundred five hundred", false, ErrMisplacedWord, "hundred"},
+		{"one thousand two thousand", false, ErrMisplacedWord, "thousand"},
+		{"one thousand million", false, ErrMisplacedWord, "million"},
+		{"five and", false, ErrMisplacedWord, "and"},
+		{"zero zero", false, ErrMisplacedWord, "zero"},
+		{"a five", false, ErrMisplacedWord, "a"},
+		{"ten quintillion", false, ErrOutOfRange, ""},
+		{"99999999999999999999", false, ErrOutOfRange, ""},
+		{"12abc", false, ErrUnknownWord, "12abc"},
+		{"twenty-one", true, ErrNotOrdinal, "one"},
+		{"21", true, ErrNotOrdinal, "21"},
+		{"21th", true, ErrWrongSuffix, "th"},
+		{"11st", true, ErrWrongSuffix, "st"},
+	} {
+		var e error
+		if test.ordinal {
+			_, e = rs.Deordinalize(test.s)
+		} else {
+			_, e = rs.WordsToNumber(test.s)
+		}
+		var n *NumberError
+		if !errors.Is(e, test.want) {
+			t.Error(test.s, "want", test.want, "got", e)
+		} else if !errors.As(e, &n) || n.Word != test.word || n.Input != test.s {
+			t.Errorf("%s: unexpected error details %#v", test.s, e)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package inflect
+
+// units for counting common uncountable nouns.
+// an empty unit means the noun can be counted as is: "3 sheep".
+func addDefaultPartitives(rs *Ruleset) {
+	for _, pair := range [][2]string{
+		{"bacon", "slice"},
+		{"bread", "loaf"},
+		{"clothing", "item"},
+		{"fish", ""},
+		{"gold", "bar"},
+		{"information", "item"},
+		{"jeans", "pair"},
+		{"lightning", "bolt"},
+		{"money", "sum"},
+		{"news", "item"},
+		{"police", ""},
+		{"rice", "grain"},
+		{"sand", "grain"},
+		{"series", ""},
+		{"sheep", ""},
+		{"soap", "bar"},
+		{"species", ""},
+		{"thunder", "clap"},
+		{"underwear", "pair"},
+	} {
+		rs.AddPartitive(pair[0], pair[1])
+	}
+	rs.SetPartitiveFallback("piece")
+}
+
+// AddPartitive: the unit used to count an uncountable noun.
+// for example, "grain" makes ToQuantity(3, "rice") -> "3 grains of rice".
+// an empty unit means the noun is counted as is: "3 sheep".
+func (rs *Ruleset) AddPartitive(word, unit string) {
+	rs.partitives = append(rs.partitives, Rule{match: word, sub: unit, exact: true})
+}
+
+// SetPartitiveFallback: the unit for uncountable nouns without a partitive of their own.
+// AddDefaultRules uses "piece": "3 pieces of equipment". an empty unit counts uncountable nouns as is.
+func (rs *Ruleset) SetPartitiveFallback(unit string) {
+	rs.partitiveFallback = unit
+}
+
+// returns the unit for counting an uncountable noun, if any.
+// compounds use their last word: "office equipment" -> "piece".
+func (rs *Ruleset) partitive(word string) (ret string) {
+	if rs.isUncountable(word) {
+		_, last := lastWord(word)
+		if unit, ok := find(rs.partitives, rs.toLower(word)); ok {
+			ret = unit
+		} else if unit, ok := find(rs.partitives, rs.toLower(last)); ok {
+			ret = unit
+		} else {
+			ret = rs.partitiveFallback
+		}
+	}
+	return
+}
+
+func AddPartitive(word, unit string) {
+	Rules.AddPartitive(word, unit)
+}
+
+func SetPartitiveFallback(unit string) {
+	Rules.SetPartitiveFallback(unit)
+}
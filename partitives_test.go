@@ -0,0 +1,51 @@
+package inflect
+
+import (
+	"testing"
+)
+
+func TestPartitives(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for _, x := range []struct {
+		n    float64
+		word string
+		opts QuantityOptions
+		want string
+	}{
+		{3, "equipment", QuantityOptions{}, "3 pieces of equipment"},
+		{1, "equipment", QuantityOptions{}, "1 piece of equipment"},
+		{1, "equipment", QuantityOptions{Spell: true}, "one piece of equipment"},
+		{0, "equipment", QuantityOptions{No: true}, "no equipment"},
+		{3, "rice", QuantityOptions{}, "3 grains of rice"},
+		{2, "information", QuantityOptions{}, "2 items of information"},
+		{2, "money", QuantityOptions{}, "2 sums of money"},
+		{2, "jeans", QuantityOptions{}, "2 pairs of jeans"},
+		{4, "news", QuantityOptions{}, "4 items of news"},
+		{3, "office equipment", QuantityOptions{}, "3 pieces of office equipment"},
+		{3, "sheep", QuantityOptions{}, "3 sheep"},
+		{3, "fish", QuantityOptions{}, "3 fish"},
+		{3, "box", QuantityOptions{}, "3 boxes"},
+	} {
+		if got := rs.ToQuantity(x.n, x.word, x.opts); got != x.want {
+			t.Error("want", x.want, "got", got)
+		}
+	}
+}
+
+func TestAddPartitive(t *testing.T) {
+	rs := AddDictionary(AddDefaultRules(&Ruleset{}))
+	if want, got := "2 pieces of luggage", rs.ToQuantity(2, "luggage", QuantityOptions{}); got != want {
+		t.Error("want", want, "got", got)
+	}
+	if want, got := "2 deer", rs.ToQuantity(2, "deer", QuantityOptions{}); got != want {
+		t.Error("want", want, "got", got)
+	}
+	rs.AddPartitive("luggage", "bag")
+	if want, got := "2 bags of luggage", rs.ToQuantity(2, "luggage", QuantityOptions{}); got != want {
+		t.Error("want", want, "got", got)
+	}
+	rs.SetPartitiveFallback("")
+	if want, got := "2 feedback", rs.ToQuantity(2, "feedback", QuantityOptions{}); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
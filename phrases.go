@@ -0,0 +1,253 @@
+package inflect
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// common English words which end the head noun of a phrase;
+// and compound nouns which don't follow the usual patterns.
+// "in" and "at" start too many modifiers ( "sign in page" ) to be prepositions; they only split at their tails.
+func addDefaultPhrases(rs *Ruleset) {
+	rs.AddPreposition("of")
+	for _, tail := range []string{
+		"in law", "in waiting", "in chief", "in charge", "in arms", "at arms", "at law",
+		"of war", "of arms", "of lading",
+	} {
+		rs.AddCompoundTail(tail)
+	}
+	for _, word := range []string{"general", "martial", "elect", "designate", "royal", "emeritus"} {
+		rs.AddPostpositive(word)
+	}
+	rs.AddCompound("passerby", "passersby")
+	rs.AddCompound("runner-up", "runners-up")
+	rs.AddCompound("hanger-on", "hangers-on")
+	rs.AddCompound("major general", "major generals")
+	rs.AddCompound("lieutenant general", "lieutenant generals")
+	rs.AddCompound("brigadier general", "brigadier generals")
+	// postpositives only split identifiers which are registered compounds: "attorney_general", "AttorneyGeneral".
+	for _, pair := range (irregulars{
+		{"attorney", "attorneys"}, {"director", "directors"}, {"governor", "governors"}, {"inspector", "inspectors"},
+		{"secretary", "secretaries"}, {"solicitor", "solicitors"}, {"surgeon", "surgeons"},
+	}) {
+		rs.AddCompound(pair[0]+" general", pair[1]+" general")
+	}
+	rs.AddCompound("court martial", "courts martial")
+}
+
+// a preposition ends the head noun of a phrase:
+// for example, "of" makes "bill of lading" -> "bills of lading".
+// prepositions only split phrases written with spaces or dashes, and only when a single word follows:
+// "cup of tea", "sheep of the field"; but "state of the art system" -> "state of the art systems".
+func (rs *Ruleset) AddPreposition(word string) {
+	rs.prepositions = append(rs.prepositions, Rule{match: word, exact: true})
+}
+
+// the end of a compound noun which starts with a preposition:
+// for example, "in law" makes "mother_in_law" -> "mothers_in_law", and "mother-in-law" -> "mothers-in-law".
+// identifiers only split at their registered tails; so "check_in_time" -> "check_in_times".
+func (rs *Ruleset) AddCompoundTail(tail string) {
+	rs.tails = append(rs.tails, Rule{match: compoundKey(tail), exact: true})
+}
+
+// an adjective which follows the head noun of a phrase:
+// for example, "general" makes "attorney general" -> "attorneys general".
+// like prepositions, they don't split identifiers: "config_general" -> "config_generals"; see AddCompound.
+func (rs *Ruleset) AddPostpositive(word string) {
+	rs.postpositives = append(rs.postpositives, Rule{match: word, exact: true})
+}
+
+// add the singular and plural forms of a compound noun;
+// for example: "passerby" and "passersby".
+// compounds match regardless of case, and of whether their words are separated by spaces, dashes, or underscores.
+func (rs *Ruleset) AddCompound(singular, plural string) {
+	rs.compounds = append(rs.compounds, Rule{
+		match: compoundKey(singular),
+		sub:   compoundKey(plural),
+		exact: true,
+	})
+}
+
+// pluralize or singularize a phrase by inflecting its head noun.
+// returns every form of the phrase that inflectWord suggests.
+func (rs *Ruleset) inflectPhrase(rules []Rule, word string, plural bool, inflectWord func(string) []string) (ret []string) {
+	if _, exact := find(rules, word); exact {
+		ret = inflectWord(word) // an exact rule for the whole phrase
+	} else if p, ok := rs.findCompound(word, plural); ok {
+		ret = []string{p}
+	} else if p, ok := rs.findCamelCompound(word, plural); ok {
+		ret = []string{p}
+	} else if prefix, head, suffix, ok := rs.headNoun(word); ok {
+		for _, h := range inflectWord(head) {
+			ret = append(ret, prefix+h+suffix)
+		}
+	} else {
+		ret = inflectWord(word)
+	}
+	return
+}
+
+// split a phrase around its head noun:
+// "bill of lading" -> "", "bill", " of lading"; "attorney general" -> "", "attorney", " general"
+func (rs *Ruleset) headNoun(phrase string) (prefix, head, suffix string, okay bool) {
+	if words := splitWords(phrase); len(words) > 1 {
+		at := -1
+		for i := 1; i+1 < len(words); i++ {
+			if rs.splitsAt(phrase, words, i) {
+				at = i - 1
+				break
+			}
+		}
+		if last := len(words) - 1; at < 0 && rs.isPhraseWord(rs.postpositives, words[last].in(phrase)) &&
+			!isIdentifier(phrase[words[last-1].end:words[last].start]) {
+			at = last - 1
+		}
+		if at >= 0 {
+			w := words[at]
+			prefix, head, suffix, okay = phrase[:w.start], phrase[w.start:w.end], phrase[w.end:], true
+		}
+	}
+	return
+}
+
+// whether the head noun of a phrase ends before its i-th word:
+// at a registered tail, or in prose, at a preposition followed by a single word.
+func (rs *Ruleset) splitsAt(phrase string, words []wordSpan, i int) (okay bool) {
+	if rs.isCompoundTail(phrase[words[i].start:]) {
+		okay = true
+	} else if rs.isPhraseWord(rs.prepositions, words[i].in(phrase)) && !isIdentifier(phrase[words[i-1].end:words[i].start]) {
+		rest := words[i+1:]
+		if len(rest) > 1 && isArticle(rest[0].in(phrase)) {
+			rest = rest[1:]
+		}
+		// "writ of habeas corpus"
+		_, uncountable := find(rs.uncountables, rs.toLower(phrase[rest[0].start:]))
+		okay = len(rest) == 1 || uncountable
+	}
+	return
+}
+
+func isArticle(word string) bool {
+	switch strings.ToLower(word) {
+	case "a", "an", "the":
+		return true
+	}
+	return false
+}
+
+func (rs *Ruleset) isPhraseWord(rules []Rule, word string) bool {
+	_, exact := find(rules, rs.toLower(word))
+	return exact
+}
+
+func (rs *Ruleset) isCompoundTail(tail string) bool {
+	_, exact := find(rs.tails, compoundKey(rs.toLower(tail)))
+	return exact
+}
+
+// identifiers separate their words with underscores or colons, rather than the spaces and dashes of prose.
+func isIdentifier(sep string) bool {
+	return strings.ContainsAny(sep, "_:")
+}
+
+// look for a compound noun, returning its singular or plural form
+// using the case and separators of the original word.
+func (rs *Ruleset) findCompound(word string, plural bool) (ret string, okay bool) {
+	key := compoundKey(rs.toLower(word))
+	for i := len(rs.compounds) - 1; i >= 0; i-- {
+		if rule := rs.compounds[i]; key == rule.match || key == rule.sub {
+			sub := rule.match
+			if plural {
+				sub = rule.sub
+			}
+			ret, okay = rs.matchWords(word, sub), true
+			break
+		}
+	}
+	return
+}
+
+// compounds written in camel case: "AttorneyGeneral" -> "AttorneysGeneral"
+func (rs *Ruleset) findCamelCompound(word string, plural bool) (ret string, okay bool) {
+	if strings.IndexFunc(word, isSpacerChar) < 0 {
+		if parts := rs.Underscore(word); strings.Contains(parts, "_") {
+			if p, ok := rs.findCompound(parts, plural); ok {
+				if first, _ := utf8.DecodeRuneInString(word); unicode.IsUpper(first) {
+					ret = rs.Camelize(p)
+				} else {
+					ret = rs.CamelizeDownFirst(p)
+				}
+				okay = true
+			}
+		}
+	}
+	return
+}
+
+// replace each of the words in phrase with the space separated words of sub.
+// keeps the case of each word, and the original separators.
+func (rs *Ruleset) matchWords(phrase, sub string) (ret string) {
+	words, subs := splitWords(phrase), strings.Fields(sub)
+	if len(words) != len(subs) {
+		ret = sub
+	} else {
+		var out strings.Builder
+		var last int
+		for i, w := range words {
+			out.WriteString(phrase[last:w.start])
+			out.WriteString(rs.matchCase(w.in(phrase), subs[i]))
+			last = w.end
+		}
+		out.WriteString(phrase[last:])
+		ret = out.String()
+	}
+	return
+}
+
+// normalize the separators of a compound "mother-in-law" -> "mother in law"
+func compoundKey(word string) string {
+	return strings.Join(strings.FieldsFunc(word, isSpacerChar), " ")
+}
+
+// byte offsets of a word within some phrase
+type wordSpan struct{ start, end int }
+
+func (w wordSpan) in(phrase string) string {
+	return phrase[w.start:w.end]
+}
+
+// find the words of a phrase which are separated by spacer chars
+func splitWords(phrase string) (ret []wordSpan) {
+	start := -1
+	for i, c := range phrase {
+		if isSpacerChar(c) {
+			if start >= 0 {
+				ret = append(ret, wordSpan{start, i})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		ret = append(ret, wordSpan{start, len(phrase)})
+	}
+	return
+}
+
+func AddPreposition(word string) {
+	Rules.AddPreposition(word)
+}
+
+func AddCompoundTail(tail string) {
+	Rules.AddCompoundTail(tail)
+}
+
+func AddPostpositive(word string) {
+	Rules.AddPostpositive(word)
+}
+
+func AddCompound(singular, plural string) {
+	Rules.AddCompound(singular, plural)
+}
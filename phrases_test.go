@@ -0,0 +1,122 @@
+package inflect
+
+import (
+	"testing"
+)
+
+var PhraseToPlural = map[string]string{
+	"attorney general":   "attorneys general",
+	"Attorney General":   "Attorneys General",
+	"mother-in-law":      "mothers-in-law",
+	"bill of lading":     "bills of lading",
+	"man of war":         "men of war",
+	"lady-in-waiting":    "ladies-in-waiting",
+	"man-at-arms":        "men-at-arms",
+	"passerby":           "passersby",
+	"Passerby":           "Passersby",
+	"court martial":      "courts martial",
+	"court-martial":      "courts-martial",
+	"president elect":    "presidents elect",
+	"runner-up":          "runners-up",
+	"hanger-on":          "hangers-on",
+	"major general":      "major generals",
+	"Major_General":      "Major_Generals",
+	"status_code":        "status_codes",
+	"cup of tea":         "cups of tea",
+	"sheep of the field": "sheep of the field",
+	// the head noun comes after these prepositions
+	"sign in page":            "sign in pages",
+	"state of the art system": "state of the art systems",
+	"person in charge":        "people in charge",
+	"AttorneyGeneral":         "AttorneysGeneral",
+	"courtMartial":            "courtsMartial",
+}
+
+func TestPluralizePhrases(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for singular, plural := range PhraseToPlural {
+		if want, got := plural, rs.Pluralize(singular); got != want {
+			t.Error("want", want, "got", got)
+		}
+		if want, got := plural, rs.Pluralize(plural); got != want {
+			t.Error("want", want, "got", got)
+		}
+		if want, got := singular, rs.Singularize(plural); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+func TestTableizePhrases(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for klass, table := range map[string]string{
+		"AttorneyGeneral": "attorneys_general",
+		"MotherInLaw":     "mothers_in_law",
+		"BillOfLading":    "bills_of_lading",
+		"CourtMartial":    "courts_martial",
+		"Passerby":        "passersby",
+		"MajorGeneral":    "major_generals",
+		"CheckInTime":     "check_in_times",
+		"OptInSetting":    "opt_in_settings",
+		"SignInEvent":     "sign_in_events",
+		"LogInAttempt":    "log_in_attempts",
+		"TimeAtStart":     "time_at_starts",
+		"ConfigGeneral":   "config_generals",
+		"SettingGeneral":  "setting_generals",
+	} {
+		if want, got := table, rs.Tableize(klass); got != want {
+			t.Error("want", want, "got", got)
+		}
+		if want, got := klass, rs.Typeify(table); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+func TestAddPhraseRules(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	if want, got := "sergeant majors", rs.Pluralize("sergeant major"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	rs.AddPostpositive("major")
+	if want, got := "sergeants major", rs.Pluralize("sergeant major"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	rs.AddCompound("drum major", "drum majors")
+	if want, got := "drum majors", rs.Pluralize("drum major"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	rs.AddPreposition("for")
+	if want, got := "calls for papers", rs.Pluralize("call for papers"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	if want, got := "call_for_papers", rs.Pluralize("call_for_papers"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	rs.AddCompoundTail("for papers")
+	if want, got := "calls_for_papers", rs.Pluralize("call_for_papers"); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
+
+// postpositives don't split identifiers unless they are registered compounds
+func TestPostpositiveIdentifiers(t *testing.T) {
+	rs := AddLegalRules(AddDefaultRules(&Ruleset{}))
+	for singular, plural := range map[string]string{
+		"domain public":   "domains public",
+		"domain_public":   "domain_publics",
+		"DomainPublic":    "DomainPublics",
+		"config_general":  "config_generals",
+		"AttorneyGeneral": "AttorneysGeneral",
+	} {
+		if want, got := plural, rs.Pluralize(singular); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+	if want, got := "domain_publics", rs.Tableize("DomainPublic"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	if want, got := rs.Underscore(rs.Pluralize("AttorneyGeneral")), rs.Tableize("AttorneyGeneral"); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
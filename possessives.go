@@ -0,0 +1,113 @@
+package inflect
+
+import "strings"
+
+// NamePossessives picks how names ending in "s" form their possessive.
+type NamePossessives int
+
+const (
+	// NamesApostropheS: "James's", "Jones's"
+	NamesApostropheS NamePossessives = iota
+	// NamesApostrophe: "James'", "Jones'"
+	NamesApostrophe
+)
+
+// SetNamePossessives changes how Possessive handles capitalized words ending in "s".
+func (rs *Ruleset) SetNamePossessives(style NamePossessives) {
+	rs.namePossessives = style
+}
+
+// Possessive: the possessive form of a singular or plural word.
+// "user" -> "user's", "users" -> "users'", "children" -> "children's", "boss" -> "boss's".
+// the irregulars and common nouns decide plurality first; uncountable and singular words take "'s": "news's", "campus's".
+// other capitalized words ending in "s" are treated as names: "James" -> "James's"; see SetNamePossessives.
+func (rs *Ruleset) Possessive(word string) (ret string) {
+	if len(word) > 0 {
+		lower := rs.toLower(word)
+		endsInS := strings.HasSuffix(lower, "s")
+		if single, ok := rs.singularizeTerm(word); ok && single != word {
+			ret = word + "'" // "CDs'", "Ph.D.s'"
+		} else if rs.isPossessiveTerm(word) {
+			ret = word + "'s" // "A's", "#'s", "CD's", "Ph.D.'s", "MP3's"; but "USER'S".
+		} else if plural, known := rs.knownPlurality(lower); endsInS && known {
+			ret = word + pluralSuffix(word, plural) // "users'", "Employees'", "news's", "campus's"
+		} else if endsInS && isProperNoun(word) {
+			ret = word + rs.nameSuffix()
+		} else if endsInS && rs.guessPlural(lower) == NumberYes {
+			ret = word + "'"
+		} else {
+			ret = word + matchLastCase(word, "'s")
+		}
+	}
+	return
+}
+
+// letters, numbers, symbols, and abbreviations keep their case: "A's", "#'s", "CD's", "Ph.D.'s", "MP3's".
+// uppercase words read as words aren't terms: "NASA'S".
+func (rs *Ruleset) isPossessiveTerm(word string) (okay bool) {
+	switch rs.classifyTerm(word) {
+	case notTerm:
+		okay = false
+	case abbreviationTerm:
+		okay = isInitialism(word) || hasDotsOrDigits(word)
+	default:
+		okay = true
+	}
+	return
+}
+
+// uncountable and ambiguous words count as singular: "sheep's", "news's".
+func pluralSuffix(word string, plural Tristate) (ret string) {
+	if plural == NumberYes {
+		ret = "'"
+	} else {
+		ret = matchLastCase(word, "'s")
+	}
+	return
+}
+
+// the possessive ending for names which end in "s"
+func (rs *Ruleset) nameSuffix() (ret string) {
+	if rs.namePossessives == NamesApostrophe {
+		ret = "'"
+	} else {
+		ret = "'s"
+	}
+	return
+}
+
+// PluralPossessive: the possessive form of a word's plural.
+// "user" -> "users'", "child" -> "children's", "sheep" -> "sheep's".
+// capitalized words which the ruleset doesn't know are names, and use PluralizeProper: "James" -> "Jameses'".
+func (rs *Ruleset) PluralPossessive(word string) (ret string) {
+	if len(word) > 0 {
+		if plural := rs.pluralizeOwner(word); strings.HasSuffix(rs.toLower(plural), "s") {
+			ret = plural + "'"
+		} else {
+			ret = plural + matchLastCase(plural, "'s")
+		}
+	}
+	return
+}
+
+// names pluralize as names, even when the ruleset doesn't use proper nouns: "Jones" -> "Joneses"
+func (rs *Ruleset) pluralizeOwner(word string) (ret string) {
+	if _, known := rs.knownPlurality(word); !known && isProperNoun(word) && strings.IndexFunc(word, isSpacerChar) < 0 {
+		ret = rs.PluralizeProper(word)
+	} else {
+		ret = rs.Pluralize(word)
+	}
+	return
+}
+
+func SetNamePossessives(style NamePossessives) {
+	Rules.SetNamePossessives(style)
+}
+
+func Possessive(word string) string {
+	return Rules.Possessive(word)
+}
+
+func PluralPossessive(word string) string {
+	return Rules.PluralPossessive(word)
+}
@@ -0,0 +1,87 @@
+package inflect
+
+import (
+	"testing"
+)
+
+var WordToPossessive = map[string]string{
+	"user":          "user's",
+	"users":         "users'",
+	"child":         "child's",
+	"children":      "children's",
+	"boss":          "boss's",
+	"bus":           "bus's",
+	"sheep":         "sheep's",
+	"news":          "news's",
+	"campus":        "campus's",
+	"walrus":        "walrus's",
+	"lens":          "lens's",
+	"Users":         "Users'",
+	"Employees":     "Employees'",
+	"Classes":       "Classes'",
+	"Jones":         "Jones's",
+	"USER":          "USER'S",
+	"BOSS":          "BOSS'S",
+	"Ph.D.s":        "Ph.D.s'",
+	"MP3":           "MP3's",
+	"1990s":         "1990s'",
+	"USERS":         "USERS'",
+	"CDs":           "CDs'",
+	"Children":      "Children's",
+	"Mary":          "Mary's",
+	"James":         "James's",
+	"CD":            "CD's",
+	"Ph.D.":         "Ph.D.'s",
+	"mother-in-law": "mother-in-law's",
+	"":              "",
+}
+
+var WordToPluralPossessive = map[string]string{
+	"user":          "users'",
+	"child":         "children's",
+	"person":        "people's",
+	"sheep":         "sheep's",
+	"box":           "boxes'",
+	"User":          "Users'",
+	"Child":         "Children's",
+	"CD":            "CDs'",
+	"mother-in-law": "mothers-in-law's",
+	"James":         "Jameses'",
+	"Jones":         "Joneses'",
+	"Smith":         "Smiths'",
+	"BoxCategory":   "BoxCategories'",
+	"":              "",
+}
+
+func TestPossessive(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for word, possessive := range WordToPossessive {
+		if want, got := possessive, rs.Possessive(word); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+func TestPluralPossessive(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for word, possessive := range WordToPluralPossessive {
+		if want, got := possessive, rs.PluralPossessive(word); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+func TestNamePossessives(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	rs.SetNamePossessives(NamesApostrophe)
+	if want, got := "James'", rs.Possessive("James"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	if want, got := "boss's", rs.Possessive("boss"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	rs.SetProperNouns(true)
+	if want, got := "Joneses'", rs.PluralPossessive("Jones"); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
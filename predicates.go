@@ -0,0 +1,115 @@
+package inflect
+
+// Tristate answers questions about words which might not have a single answer.
+type Tristate int
+
+const (
+	NumberNo Tristate = iota
+	NumberYes
+	NumberAmbiguous // ex. "sheep" is both singular and plural
+)
+
+func (t Tristate) String() (ret string) {
+	switch t {
+	case NumberNo:
+		ret = "NumberNo"
+	case NumberYes:
+		ret = "NumberYes"
+	case NumberAmbiguous:
+		ret = "NumberAmbiguous"
+	default:
+		ret = "Tristate(?)"
+	}
+	return
+}
+
+// IsUncountable: whether a word has no plural; checks the uncountables added to the ruleset
+// including those from AddDictionary. compounds are uncountable if their last word is: "funky jeans".
+func (rs *Ruleset) IsUncountable(word string) bool {
+	return rs.isUncountable(word)
+}
+
+// IsPlural: "users" is, "user" isn't, and "sheep" and "news" are ambiguous.
+// irregulars, the common nouns, and any dictionary added to the ruleset decide first:
+// so "walrus" and "campus" are singular. other words rely on Pluralize and Singularize.
+func (rs *Ruleset) IsPlural(word string) (ret Tristate) {
+	if known, ok := rs.knownPlurality(word); ok {
+		ret = known
+	} else {
+		ret = rs.guessPlural(word)
+	}
+	return
+}
+
+// decide plurality from the uncountables, irregulars, and common nouns alone.
+func (rs *Ruleset) knownPlurality(word string) (ret Tristate, okay bool) {
+	if rs.isUncountable(word) {
+		ret, okay = NumberAmbiguous, true
+	} else {
+		singular := rs.Singularize(word)
+		knownSingular := rs.isKnownSingular(word)
+		// the singular has to pluralize back to the word: "xGA" isn't the plural of "xGUM".
+		knownPlural := singular != word && rs.isKnownSingular(singular) && rs.Pluralize(singular) == word
+		switch {
+		case knownSingular && knownPlural:
+			ret, okay = NumberAmbiguous, true
+		case knownSingular:
+			ret, okay = NumberNo, true
+		case knownPlural:
+			ret, okay = NumberYes, true
+		}
+	}
+	return
+}
+
+// true if the last word of a compound is an irregular singular or a common noun.
+func (rs *Ruleset) isKnownSingular(word string) (okay bool) {
+	_, last := lastWord(word)
+	lower := rs.toLower(last)
+	if plural, exact := find(rs.plurals, lower); exact {
+		okay = plural != lower // irregulars also map their plurals to themselves: "geese" -> "geese"
+	} else {
+		okay = rs.isNoun(lower)
+	}
+	return
+}
+
+// use the same rules as Pluralize and Singularize, so it's only as accurate as those are.
+func (rs *Ruleset) guessPlural(word string) (ret Tristate) {
+	changesWhenSingular := rs.Singularize(word) != word
+	changesWhenPlural := rs.Pluralize(word) != word
+	switch {
+	case changesWhenSingular && !changesWhenPlural:
+		ret = NumberYes
+	case !changesWhenSingular && changesWhenPlural:
+		ret = NumberNo
+	default:
+		ret = NumberAmbiguous
+	}
+	return
+}
+
+// IsSingular: "user" is, "users" isn't, and "sheep" and "news" are ambiguous.
+func (rs *Ruleset) IsSingular(word string) (ret Tristate) {
+	switch rs.IsPlural(word) {
+	case NumberYes:
+		ret = NumberNo
+	case NumberNo:
+		ret = NumberYes
+	default:
+		ret = NumberAmbiguous
+	}
+	return
+}
+
+func IsUncountable(word string) bool {
+	return Rules.IsUncountable(word)
+}
+
+func IsPlural(word string) Tristate {
+	return Rules.IsPlural(word)
+}
+
+func IsSingular(word string) Tristate {
+	return Rules.IsSingular(word)
+}
@@ -0,0 +1,70 @@
+package inflect
+
+import (
+	"testing"
+)
+
+var WordToPlurality = map[string]Tristate{
+	"users":           NumberYes,
+	"user":            NumberNo,
+	"people":          NumberYes,
+	"person":          NumberNo,
+	"node_children":   NumberYes,
+	"NodeChild":       NumberNo,
+	"data":            NumberYes,
+	"indices":         NumberYes,
+	"status":          NumberNo,
+	"statuses":        NumberYes,
+	"bills of lading": NumberYes,
+	"bill of lading":  NumberNo,
+	"sheep":           NumberAmbiguous,
+	"news":            NumberAmbiguous,
+	"funky jeans":     NumberAmbiguous,
+	"":                NumberAmbiguous,
+	"walrus":          NumberNo,
+	"iris":            NumberNo,
+	"apparatus":       NumberNo,
+	"campus":          NumberNo,
+	"bonus":           NumberNo,
+	"corpus":          NumberNo,
+	"campuses":        NumberYes,
+	"leaves":          NumberYes,
+}
+
+func TestIsPlural(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for word, plural := range WordToPlurality {
+		if want, got := plural, rs.IsPlural(word); got != want {
+			t.Error(word, "want", want, "got", got)
+		}
+		singular := plural
+		if plural != NumberAmbiguous {
+			singular = 1 - plural
+		}
+		if want, got := singular, rs.IsSingular(word); got != want {
+			t.Error(word, "want", want, "got", got)
+		}
+	}
+}
+
+func TestIsUncountable(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for word, uncountable := range map[string]bool{
+		"sheep":       true,
+		"funky_jeans": true,
+		"FunkyFish":   true,
+		"goose":       false,
+		"users":       false,
+	} {
+		if want, got := uncountable, rs.IsUncountable(word); got != want {
+			t.Error(word, "want", want, "got", got)
+		}
+	}
+	AddDictionary(rs)
+	if want, got := true, rs.IsUncountable("feedback"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	if want, got := NumberYes, rs.IsPlural("geese"); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
@@ -0,0 +1,106 @@
+package inflect
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// endings of names which take "es" rather than "s"
+var sibilantEndings = []string{"s", "x", "z", "ch", "sh"}
+
+// names which end in "es" but aren't plural.
+// other names ending in "es" are plural names ending in "e": "Kates" -> "Kate".
+var namesEndingInEs = map[string]bool{
+	"achilles": true, "agnes": true, "archimedes": true, "barnes": true, "charles": true,
+	"dolores": true, "flores": true, "gates": true, "hercules": true, "hermes": true,
+	"holmes": true, "hughes": true, "james": true, "jones": true, "mercedes": true,
+	"moses": true, "reyes": true, "rhodes": true, "socrates": true, "torres": true,
+	"ulysses": true, "wales": true, "yates": true,
+}
+
+// SetProperNouns changes whether Pluralize and Singularize treat capitalized words as names.
+// names ignore the usual rules: "Mary" -> "Marys", "Jones" -> "Joneses", "Goodman" -> "Goodmans".
+// off by default, because capitalized identifiers like "Category" aren't names.
+func (rs *Ruleset) SetProperNouns(auto bool) {
+	rs.properNouns = auto
+}
+
+// returns the plural form of a name: "Mary" -> "Marys", "Jones" -> "Joneses", "Kennedy" -> "Kennedys"
+func (rs *Ruleset) PluralizeProper(name string) (ret string) {
+	if len(name) > 0 {
+		lower := rs.toLower(name)
+		suffix := "s"
+		for _, end := range sibilantEndings {
+			if strings.HasSuffix(lower, end) {
+				suffix = "es"
+				break
+			}
+		}
+		ret = name + matchLastCase(name, suffix)
+	}
+	return
+}
+
+// returns the singular form of a pluralized name: "Marys" -> "Mary", "Joneses" -> "Jones".
+// names which already end in "s" stay as they are: "Jones", "James", "Ross", "Status".
+func (rs *Ruleset) SingularizeProper(name string) (ret string) {
+	lower := rs.toLower(name)
+	ret = name
+	if trimmed := strings.TrimSuffix(lower, "es"); len(trimmed) < len(lower) && isSibilantName(trimmed) {
+		ret = name[:len(name)-2]
+	} else if strings.HasSuffix(lower, "s") && !endsInNameS(lower) {
+		ret = name[:len(name)-1]
+	}
+	return
+}
+
+// true if a lowercase name ends in a sound which would have taken "es": "max", "church", "jones"
+func isSibilantName(lower string) (okay bool) {
+	if strings.HasSuffix(lower, "s") {
+		okay = endsInNameS(lower)
+	} else {
+		for _, end := range sibilantEndings {
+			if strings.HasSuffix(lower, end) {
+				okay = true
+				break
+			}
+		}
+	}
+	return
+}
+
+// true if the final "s" of a lowercase name belongs to the name: "ross", "jones", "davis", "marcus"
+func endsInNameS(lower string) (okay bool) {
+	if okay = namesEndingInEs[lower]; !okay {
+		for _, end := range []string{"ss", "is", "us"} {
+			if strings.HasSuffix(lower, end) {
+				okay = true
+				break
+			}
+		}
+	}
+	return
+}
+
+// a capitalized word that isn't all uppercase: "Mary", "McDonald", but not "HTML"
+func isProperNoun(word string) bool {
+	first, _ := utf8.DecodeRuneInString(word)
+	return unicode.IsUpper(first) && strings.IndexFunc(word, unicode.IsLower) >= 0
+}
+
+// uppercase the suffix if the word ends with an uppercase letter: "JONES" -> "ES"
+func matchLastCase(word, suffix string) string {
+	if last, _ := utf8.DecodeLastRuneInString(word); unicode.IsUpper(last) {
+		suffix = strings.ToUpper(suffix)
+	}
+	return suffix
+}
+
+func PluralizeProper(name string) string {
+	return Rules.PluralizeProper(name)
+}
+
+func SingularizeProper(name string) string {
+	return Rules.SingularizeProper(name)
+}
@@ -0,0 +1,92 @@
+package inflect
+
+import (
+	"testing"
+)
+
+var NameToPlural = map[string]string{
+	"Mary":       "Marys",
+	"Jones":      "Joneses",
+	"Kennedy":    "Kennedys",
+	"Germany":    "Germanys",
+	"Smith":      "Smiths",
+	"Max":        "Maxes",
+	"Fritz":      "Fritzes",
+	"Church":     "Churches",
+	"Bush":       "Bushes",
+	"Goodman":    "Goodmans",
+	"Wolf":       "Wolfs",
+	"Romano":     "Romanos",
+	"Child":      "Childs",
+	"John Smith": "John Smiths",
+	"JONES":      "JONESES",
+	"James":      "Jameses",
+	"Ross":       "Rosses",
+	"Boss":       "Bosses",
+	"Davis":      "Davises",
+	"Kate":       "Kates",
+	"Mike":       "Mikes",
+	"George":     "Georges",
+	"Jane":       "Janes",
+	"Rose":       "Roses",
+	"Moses":      "Moseses",
+}
+
+func TestPluralizeProper(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for name, plural := range NameToPlural {
+		if want, got := plural, rs.PluralizeProper(name); got != want {
+			t.Error("want", want, "got", got)
+		}
+		if want, got := name, rs.SingularizeProper(plural); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+// with proper nouns on, every name should survive the round trip.
+func TestProperNounRoundTrip(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	rs.SetProperNouns(true)
+	for name := range NameToPlural {
+		if want, got := name, rs.Singularize(rs.Pluralize(name)); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+func TestAutomaticProperNouns(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	if want, got := "Maries", rs.Pluralize("Mary"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	rs.SetProperNouns(true)
+	if want, got := "Marys", rs.Pluralize("Mary"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	if want, got := "Joneses", rs.Pluralize("Jones"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	if want, got := "Kennedy", rs.Singularize("Kennedys"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	// lowercase words follow the usual rules
+	if want, got := "ladies", rs.Pluralize("lady"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	if want, got := "HTMLs", rs.Pluralize("HTML"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	// names ending in "s" aren't plural
+	for _, name := range []string{"Jones", "James", "Ross", "Boss", "Moses", "Address", "Status"} {
+		if want, got := name, rs.Singularize(name); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+	if want, got := "address_id", rs.ForeignKey("Address"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	if want, got := "status_id", rs.ForeignKey("Status"); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
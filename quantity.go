@@ -0,0 +1,61 @@
+package inflect
+
+import (
+	"math"
+	"strconv"
+)
+
+// QuantityOptions change how ToQuantity writes numbers.
+type QuantityOptions struct {
+	Spell bool // spell out whole numbers: "three boxes" rather than "3 boxes"
+	No    bool // write zero as "no": "no boxes" rather than "0 boxes"
+}
+
+// PluralizeCount returns word as is for a count of one, and its plural for everything else:
+// 1 -> "box"; 0, 2, -3, or 1.5 -> "boxes". word should be singular; uncountable words never change.
+func (rs *Ruleset) PluralizeCount(n float64, word string) (ret string) {
+	if n == 1 || n == -1 {
+		ret = word
+	} else {
+		ret = rs.Pluralize(word)
+	}
+	return
+}
+
+// ToQuantity writes a number followed by the right form of word:
+// "1 box", "3 boxes", "1.5 hours", or with options: "no boxes", "one box".
+// uncountable nouns are counted using their partitive: "3 pieces of equipment"; see AddPartitive.
+func (rs *Ruleset) ToQuantity(n float64, word string, opts QuantityOptions) (ret string) {
+	if n == 0 && opts.No {
+		ret = "no " + rs.PluralizeCount(n, word)
+	} else {
+		var num string
+		if whole, ok := wholeNumber(n); ok && opts.Spell {
+			num = rs.NumberToWords(whole)
+		} else {
+			num = strconv.FormatFloat(n, 'f', -1, 64)
+		}
+		if unit := rs.partitive(word); len(unit) > 0 {
+			ret = num + " " + rs.PluralizeCount(n, unit) + " of " + word
+		} else {
+			ret = num + " " + rs.PluralizeCount(n, word)
+		}
+	}
+	return
+}
+
+// returns true if n is an integer that fits in an int64
+func wholeNumber(n float64) (ret int64, okay bool) {
+	if n == math.Trunc(n) && n >= math.MinInt64 && n < math.MaxInt64 {
+		ret, okay = int64(n), true
+	}
+	return
+}
+
+func PluralizeCount(n float64, word string) string {
+	return Rules.PluralizeCount(n, word)
+}
+
+func ToQuantity(n float64, word string, opts QuantityOptions) string {
+	return Rules.ToQuantity(n, word, opts)
+}
@@ -0,0 +1,60 @@
+package inflect
+
+import (
+	"testing"
+)
+
+func TestPluralizeCount(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for n, want := range map[float64]string{
+		1:    "box",
+		-1:   "box",
+		0:    "boxes",
+		2:    "boxes",
+		-3:   "boxes",
+		1.5:  "boxes",
+		0.5:  "boxes",
+		1000: "boxes",
+	} {
+		if got := rs.PluralizeCount(n, "box"); got != want {
+			t.Error(n, "want", want, "got", got)
+		}
+	}
+	if want, got := "sheep", rs.PluralizeCount(3, "sheep"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	// singular words ending in "s" stay as they are
+	for _, word := range []string{"walrus", "iris", "Jones", "status"} {
+		if want, got := word, rs.PluralizeCount(1, word); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+func TestToQuantity(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for _, x := range []struct {
+		n    float64
+		word string
+		opts QuantityOptions
+		want string
+	}{
+		{1, "box", QuantityOptions{}, "1 box"},
+		{3, "box", QuantityOptions{}, "3 boxes"},
+		{0, "box", QuantityOptions{}, "0 boxes"},
+		{0, "box", QuantityOptions{No: true}, "no boxes"},
+		{1, "box", QuantityOptions{Spell: true}, "one box"},
+		{21, "box", QuantityOptions{Spell: true}, "twenty-one boxes"},
+		{0, "box", QuantityOptions{Spell: true}, "zero boxes"},
+		{-1, "degree", QuantityOptions{}, "-1 degree"},
+		{-2, "degree", QuantityOptions{Spell: true}, "minus two degrees"},
+		{1.5, "hour", QuantityOptions{}, "1.5 hours"},
+		{1.5, "hour", QuantityOptions{Spell: true}, "1.5 hours"},
+		{3, "equipment", QuantityOptions{}, "3 pieces of equipment"},
+		{2, "person", QuantityOptions{}, "2 people"},
+	} {
+		if got := rs.ToQuantity(x.n, x.word, x.opts); got != x.want {
+			t.Error("want", x.want, "got", got)
+		}
+	}
+}
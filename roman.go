@@ -0,0 +1,189 @@
+package inflect
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrNotRoman: the text passed to FromRoman isn't a valid roman numeral.
+var ErrNotRoman = errors.New("invalid roman numeral")
+
+// RomanOptions change how FormatRoman writes numerals.
+type RomanOptions struct {
+	Lower    bool // "mcmxcix" rather than "MCMXCIX"
+	Unicode  bool // use the unicode roman numeral characters: "ⅯⅭⅯⅩⅭⅠⅩ"
+	Vinculum bool // allow numbers up to 3,999,999 by overlining thousands: 5000 -> "V̅"
+}
+
+const (
+	maxRoman   = 3999
+	overline   = '\u0305' // combining overline
+	romanDigit = "IVXLCDM"
+)
+
+var romanValues = []struct {
+	value   int
+	numeral string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+	{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+	{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+// ToRoman: 1999 -> "MCMXCIX"; returns an empty string for numbers outside of 1 to 3999.
+func (rs *Ruleset) ToRoman(n int) (ret string) {
+	ret, _ = rs.FormatRoman(n, RomanOptions{})
+	return
+}
+
+// ToRomanLower: 1999 -> "mcmxcix"; returns an empty string for numbers outside of 1 to 3999.
+func (rs *Ruleset) ToRomanLower(n int) (ret string) {
+	ret, _ = rs.FormatRoman(n, RomanOptions{Lower: true})
+	return
+}
+
+// FormatRoman writes a number as a roman numeral, returning ErrOutOfRange for numbers less than one,
+// or greater than 3999 ( 3,999,999 with the vinculum option. )
+func (rs *Ruleset) FormatRoman(n int, opts RomanOptions) (ret string, err error) {
+	if n < 1 || (n > maxRoman && (!opts.Vinculum || n > maxRoman*1000+999)) {
+		err = &NumberError{Input: strconv.Itoa(n), Err: ErrOutOfRange}
+	} else {
+		var out strings.Builder
+		if n > maxRoman {
+			// each letter of the thousands gets an overline
+			for _, c := range romanNumeral(n / 1000) {
+				out.WriteRune(c)
+				out.WriteRune(overline)
+			}
+			n %= 1000
+		}
+		out.WriteString(romanNumeral(n))
+		ret = out.String()
+		if opts.Lower {
+			ret = strings.ToLower(ret)
+		}
+		if opts.Unicode {
+			ret = strings.Map(toRomanRune, ret)
+		}
+	}
+	return
+}
+
+// FromRoman reads a roman numeral in any of the forms written by FormatRoman: "MCMXCIX", "mcmxcix", "ⅯⅭⅯⅩⅭⅠⅩ", "V̅".
+// numerals must be written in the standard, shortest form: "IIII", "VX", and "IC" are errors.
+func (rs *Ruleset) FromRoman(s string) (ret int, err error) {
+	var ascii strings.Builder
+	for _, c := range strings.TrimSpace(s) {
+		if exp, ok := fromRomanRune(c); ok {
+			ascii.WriteString(exp)
+		} else if c == overline {
+			ascii.WriteRune(c)
+		} else if upper := unicode.ToUpper(c); strings.ContainsRune(romanDigit, upper) {
+			ascii.WriteRune(upper)
+		} else {
+			return 0, &NumberError{Input: s, Word: string(c), Err: ErrNotRoman}
+		}
+	}
+	numeral := ascii.String()
+	if len(numeral) == 0 {
+		err = &NumberError{Input: s, Err: ErrNoNumber}
+	} else {
+		var total, prev int
+		runes := []rune(numeral)
+		for i := len(runes) - 1; i >= 0; i-- {
+			if runes[i] != overline {
+				v := romanValue(runes[i])
+				if i+1 < len(runes) && runes[i+1] == overline {
+					v *= 1000
+				}
+				if v < prev {
+					total -= v
+				} else {
+					total += v
+					prev = v
+				}
+			}
+		}
+		// the only valid numeral is the one we would have written.
+		if canon, e := rs.FormatRoman(total, RomanOptions{Vinculum: true}); e != nil || canon != numeral {
+			err = &NumberError{Input: s, Err: ErrNotRoman}
+		} else {
+			ret = total
+		}
+	}
+	return
+}
+
+// 1 to 3999
+func romanNumeral(n int) string {
+	var out strings.Builder
+	for _, r := range romanValues {
+		for ; n >= r.value; n -= r.value {
+			out.WriteString(r.numeral)
+		}
+	}
+	return out.String()
+}
+
+func romanValue(c rune) (ret int) {
+	for _, r := range romanValues {
+		if len(r.numeral) == 1 && rune(r.numeral[0]) == c {
+			ret = r.value
+			break
+		}
+	}
+	return
+}
+
+// unicode has a character for each roman letter: U+2160 to U+216F ( uppercase ) and U+2170 to U+217F ( lowercase. )
+// and a few combined characters for numbers like twelve: "Ⅻ".
+const (
+	romanUpper = "ⅠⅡⅢⅣⅤⅥⅦⅧⅨⅩⅪⅫⅬⅭⅮⅯ"
+	romanLower = "ⅰⅱⅲⅳⅴⅵⅶⅷⅸⅹⅺⅻⅼⅽⅾⅿ"
+)
+
+var romanExpansions = []string{
+	"I", "II", "III", "IV", "V", "VI", "VII", "VIII", "IX", "X", "XI", "XII", "L", "C", "D", "M",
+}
+
+func toRomanRune(c rune) (ret rune) {
+	ret = c
+	for i, exp := range romanExpansions {
+		if len(exp) == 1 && rune(exp[0]) == unicode.ToUpper(c) {
+			if unicode.IsLower(c) {
+				ret = []rune(romanLower)[i]
+			} else {
+				ret = []rune(romanUpper)[i]
+			}
+			break
+		}
+	}
+	return
+}
+
+func fromRomanRune(c rune) (ret string, okay bool) {
+	if i := strings.IndexRune(romanUpper, c); i >= 0 {
+		ret, okay = romanExpansions[len([]rune(romanUpper[:i]))], true
+	} else if i := strings.IndexRune(romanLower, c); i >= 0 {
+		ret, okay = romanExpansions[len([]rune(romanLower[:i]))], true
+	}
+	return
+}
+
+func ToRoman(n int) string {
+	return Rules.ToRoman(n)
+}
+
+func ToRomanLower(n int) string {
+	return Rules.ToRomanLower(n)
+}
+
+func FormatRoman(n int, opts RomanOptions) (string, error) {
+	return Rules.FormatRoman(n, opts)
+}
+
+func FromRoman(s string) (int, error) {
+	return Rules.FromRoman(s)
+}
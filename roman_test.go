@@ -0,0 +1,100 @@
+package inflect
+
+import (
+	"errors"
+	"testing"
+)
+
+var NumberToRoman = map[int]string{
+	1:    "I",
+	4:    "IV",
+	9:    "IX",
+	14:   "XIV",
+	40:   "XL",
+	90:   "XC",
+	400:  "CD",
+	1999: "MCMXCIX",
+	2024: "MMXXIV",
+	3999: "MMMCMXCIX",
+	0:    "",
+	-1:   "",
+	4000: "",
+}
+
+func TestToRoman(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for n, want := range NumberToRoman {
+		if got := rs.ToRoman(n); got != want {
+			t.Error(n, "want", want, "got", got)
+		}
+	}
+	if want, got := "mcmxcix", rs.ToRomanLower(1999); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
+
+func TestFormatRoman(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for _, test := range []struct {
+		n    int
+		opts RomanOptions
+		want string
+	}{
+		{1999, RomanOptions{Unicode: true}, "ⅯⅭⅯⅩⅭⅠⅩ"},
+		{1999, RomanOptions{Unicode: true, Lower: true}, "ⅿⅽⅿⅹⅽⅰⅹ"},
+		{4000, RomanOptions{Vinculum: true}, "I̅V̅"},
+		{5001, RomanOptions{Vinculum: true}, "V̅I"},
+		{3999, RomanOptions{Vinculum: true}, "MMMCMXCIX"},
+		{3999999, RomanOptions{Vinculum: true}, "M̅M̅M̅C̅M̅X̅C̅I̅X̅CMXCIX"},
+	} {
+		if got, e := rs.FormatRoman(test.n, test.opts); e != nil {
+			t.Error(test.n, e)
+		} else if got != test.want {
+			t.Error(test.n, "want", test.want, "got", got)
+		}
+	}
+	for _, n := range []int{0, 4000000} {
+		if _, e := rs.FormatRoman(n, RomanOptions{Vinculum: true}); !errors.Is(e, ErrOutOfRange) {
+			t.Error(n, "expected out of range, got", e)
+		}
+	}
+}
+
+func TestFromRoman(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for n, numeral := range NumberToRoman {
+		if n > 0 && n <= 3999 {
+			if got, e := rs.FromRoman(numeral); e != nil || got != n {
+				t.Error(numeral, "want", n, "got", got, e)
+			}
+		}
+	}
+	for numeral, want := range map[string]int{
+		"mcmxcix": 1999,
+		" XIV ":   14,
+		"ⅯⅭⅯⅩⅭⅠⅩ": 1999,
+		"Ⅻ":       12,
+		"ⅿⅿⅹⅺⅴ":   2024,
+		"V̅I":     5001,
+	} {
+		if got, e := rs.FromRoman(numeral); e != nil || got != want {
+			t.Error(numeral, "want", want, "got", got, e)
+		}
+	}
+	for numeral, want := range map[string]error{
+		"":     ErrNoNumber,
+		"IIII": ErrNotRoman,
+		"VX":   ErrNotRoman,
+		"IC":   ErrNotRoman,
+		"MMMM": ErrNotRoman,
+		"XVV":  ErrNotRoman,
+		"I̅":   ErrNotRoman, // 1000 is "M"
+		"̅":    ErrNotRoman,
+		"XIVa": ErrNotRoman,
+		"12":   ErrNotRoman,
+	} {
+		if _, e := rs.FromRoman(numeral); !errors.Is(e, want) {
+			t.Errorf("%q want %v got %v", numeral, want, e)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package inflect
+
+import "strings"
+
+// Conjunction joins the last item of a list.
+type Conjunction int
+
+const (
+	ConjunctionAnd Conjunction = iota // "a, b, and c"
+	ConjunctionOr                     // "a, b, or c"
+)
+
+// ListStyle: how a language joins the items of a list.
+// the connectors include their own spacing, so languages that don't use spaces can leave them out.
+type ListStyle struct {
+	Separator   string // between items: ", "
+	And, Or     string // before the last item: " and ", " or "
+	SerialComma bool   // whether three or more items use the separator before the conjunction: "a, b, and c"
+}
+
+// the style used when no other matches.
+var englishList = ListStyle{Separator: ", ", And: " and ", Or: " or ", SerialComma: true}
+
+func addDefaultListStyles(rs *Ruleset) {
+	rs.AddListStyle("en", englishList)
+	rs.AddListStyle("en-GB", ListStyle{Separator: ", ", And: " and ", Or: " or "})
+	rs.AddListStyle("de", ListStyle{Separator: ", ", And: " und ", Or: " oder "})
+	rs.AddListStyle("es", ListStyle{Separator: ", ", And: " y ", Or: " o "})
+	rs.AddListStyle("fr", ListStyle{Separator: ", ", And: " et ", Or: " ou "})
+	rs.AddListStyle("it", ListStyle{Separator: ", ", And: " e ", Or: " o "})
+	rs.AddListStyle("nl", ListStyle{Separator: ", ", And: " en ", Or: " of "})
+	rs.AddListStyle("pt", ListStyle{Separator: ", ", And: " e ", Or: " ou "})
+	rs.AddListStyle("ja", ListStyle{Separator: "、", And: "、", Or: "、または"})
+	rs.AddListStyle("zh", ListStyle{Separator: "、", And: "和", Or: "或"})
+}
+
+// AddListStyle: the separators ToSentence uses for a locale ( ex. "fr", or "en-GB" )
+func (rs *Ruleset) AddListStyle(locale string, style ListStyle) {
+	if rs.listStyles == nil {
+		rs.listStyles = make(map[string]ListStyle)
+	}
+	rs.listStyles[strings.ToLower(locale)] = style
+}
+
+// SentenceOptions change how ToSentence joins items.
+// the connectors, when set, override the ones from the locale.
+type SentenceOptions struct {
+	Locale            string      // "en" when empty; "en-GB" falls back to "en" if there's no style for "en-GB"
+	Conjunction       Conjunction // ConjunctionAnd or ConjunctionOr
+	NoSerialComma     bool        // "a, b and c" even if the locale uses a serial comma
+	WordsConnector    string      // between items: ", "
+	TwoWordsConnector string      // between exactly two items: " and "
+	LastWordConnector string      // before the last of three or more items: ", and "
+	Limit             int         // when more than zero, the most items to list before counting the rest: "a, b, and 3 others"
+	Others            string      // what to call the rest; "other" when empty
+}
+
+// ToSentence joins items into a readable list:
+// ["a"] -> "a", ["a", "b"] -> "a and b", ["a", "b", "c"] -> "a, b, and c".
+func (rs *Ruleset) ToSentence(items []string, opts SentenceOptions) (ret string) {
+	style := rs.listStyle(opts.Locale)
+	conj := style.And
+	if opts.Conjunction == ConjunctionOr {
+		conj = style.Or
+	}
+	words, two, last := style.Separator, conj, conj
+	if style.SerialComma && !opts.NoSerialComma {
+		last = strings.TrimRight(words, " ") + " " + strings.TrimLeft(conj, " ")
+	}
+	if len(opts.WordsConnector) > 0 {
+		words = opts.WordsConnector
+	}
+	if len(opts.TwoWordsConnector) > 0 {
+		two = opts.TwoWordsConnector
+	}
+	if len(opts.LastWordConnector) > 0 {
+		last = opts.LastWordConnector
+	}
+	if rest := len(items) - opts.Limit; opts.Limit > 0 && rest > 0 {
+		others := opts.Others
+		if len(others) == 0 {
+			others = "other"
+		}
+		items = append(items[:opts.Limit:opts.Limit], rs.ToQuantity(float64(rest), others, QuantityOptions{}))
+	}
+	switch cnt := len(items); cnt {
+	case 0:
+	case 1:
+		ret = items[0]
+	case 2:
+		ret = items[0] + two + items[1]
+	default:
+		ret = strings.Join(items[:cnt-1], words) + last + items[cnt-1]
+	}
+	return
+}
+
+// an exact match for locale, or its language, or english.
+func (rs *Ruleset) listStyle(locale string) (ret ListStyle) {
+	locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	if style, ok := rs.listStyles[locale]; ok {
+		ret = style
+	} else if i := strings.IndexByte(locale, '-'); i > 0 {
+		ret = rs.listStyle(locale[:i])
+	} else if style, ok := rs.listStyles["en"]; ok {
+		ret = style
+	} else {
+		ret = englishList
+	}
+	return
+}
+
+func AddListStyle(locale string, style ListStyle) {
+	Rules.AddListStyle(locale, style)
+}
+
+func ToSentence(items []string, opts SentenceOptions) string {
+	return Rules.ToSentence(items, opts)
+}
@@ -0,0 +1,59 @@
+package inflect
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestToSentence(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for _, test := range []struct {
+		items []string
+		opts  SentenceOptions
+		want  string
+	}{
+		{nil, SentenceOptions{}, ""},
+		{[]string{"users"}, SentenceOptions{}, "users"},
+		{[]string{"users", "groups"}, SentenceOptions{}, "users and groups"},
+		{[]string{"users", "groups", "roles"}, SentenceOptions{}, "users, groups, and roles"},
+		{[]string{"users", "groups", "roles"}, SentenceOptions{Conjunction: ConjunctionOr}, "users, groups, or roles"},
+		{[]string{"users", "groups", "roles"}, SentenceOptions{NoSerialComma: true}, "users, groups and roles"},
+		{[]string{"users", "groups", "roles"}, SentenceOptions{Locale: "en-GB"}, "users, groups and roles"},
+		{[]string{"users", "groups", "roles"}, SentenceOptions{Locale: "en_US"}, "users, groups, and roles"},
+		{[]string{"a", "b", "c"}, SentenceOptions{Locale: "fr"}, "a, b et c"},
+		{[]string{"a", "b", "c"}, SentenceOptions{Locale: "de-AT", Conjunction: ConjunctionOr}, "a, b oder c"},
+		{[]string{"a", "b", "c"}, SentenceOptions{Locale: "zh"}, "a、b和c"},
+		{[]string{"a", "b", "c"}, SentenceOptions{Locale: "xx"}, "a, b, and c"},
+		{[]string{"a", "b"}, SentenceOptions{TwoWordsConnector: " & "}, "a & b"},
+		{[]string{"a", "b", "c"}, SentenceOptions{WordsConnector: "; ", LastWordConnector: "; and "}, "a; b; and c"},
+		{[]string{"a", "b", "c", "d", "e"}, SentenceOptions{Limit: 2}, "a, b, and 3 others"},
+		{[]string{"a", "b", "c"}, SentenceOptions{Limit: 2}, "a, b, and 1 other"},
+		{[]string{"a", "b", "c"}, SentenceOptions{Limit: 1, Others: "person"}, "a and 2 people"},
+		{[]string{"a", "b", "c"}, SentenceOptions{Limit: 3}, "a, b, and c"},
+	} {
+		if got := rs.ToSentence(test.items, test.opts); got != test.want {
+			t.Error("want", test.want, "got", got)
+		}
+	}
+}
+
+func TestToSentenceLimit(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	items := []string{"a", "b", "c", "d"}
+	rs.ToSentence(items, SentenceOptions{Limit: 2})
+	if want, got := "a b c d", strings.Join(items, " "); got != want {
+		t.Error("the passed items shouldn't change; want", want, "got", got)
+	}
+}
+
+func TestAddListStyle(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	rs.AddListStyle("en", ListStyle{Separator: ", ", And: " plus ", Or: " or "})
+	if want, got := "a, b plus c", rs.ToSentence([]string{"a", "b", "c"}, SentenceOptions{}); got != want {
+		t.Error("want", want, "got", got)
+	}
+	var empty Ruleset
+	if want, got := "a, b, and c", empty.ToSentence([]string{"a", "b", "c"}, SentenceOptions{}); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
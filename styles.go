@@ -0,0 +1,117 @@
+package inflect
+
+import "errors"
+
+// ErrUnknownStyle is returned by AddStyle for values other than the Style constants.
+var ErrUnknownStyle = errors.New("inflect: unknown style")
+
+// Style picks between the English and the Latin or Greek plurals of borrowed words.
+type Style int
+
+const (
+	// RailsStyle matches AddDefaultRules: "index" -> "indices", "octopus" -> "octopi", "virus" -> "viri"
+	RailsStyle Style = iota
+	// ModernStyle uses anglicized plurals: "index" -> "indexes", "octopus" -> "octopuses", "schema" -> "schemas"
+	ModernStyle
+	// ClassicalStyle uses the original plurals: "index" -> "indices", "octopus" -> "octopodes", "schema" -> "schemata"
+	ClassicalStyle
+)
+
+// pairs of singular and plural words
+type irregulars [][2]string
+
+// each noun which changes between styles: its singular, then its plural in each style.
+// a style adds irregulars for the plurals which differ from the rails ones, after removing those of any earlier style.
+// words like "alumnus" have no anglicized plural; and the rails plurals are what AddDefaultRules would produce.
+var stylePlurals = [][4]string{
+	// singular, rails, modern, classical
+	{"alumnus", "alumnus", "alumni", "alumni"},
+	{"antenna", "antennas", "antennas", "antennae"},
+	{"apex", "apexes", "apexes", "apices"},
+	{"appendix", "appendixes", "appendixes", "appendices"},
+	{"aquarium", "aquaria", "aquariums", "aquaria"},
+	{"automaton", "automatons", "automatons", "automata"},
+	{"axis", "axes", "axes", "axes"},
+	{"cactus", "cactus", "cactuses", "cacti"},
+	{"cherub", "cherubs", "cherubs", "cherubim"},
+	{"codex", "codexes", "codexes", "codices"},
+	{"corpus", "corpus", "corpuses", "corpora"},
+	{"criterion", "criterions", "criterions", "criteria"},
+	{"curriculum", "curriculums", "curriculums", "curricula"},
+	{"datum", "data", "data", "data"},
+	{"dogma", "dogmas", "dogmas", "dogmata"},
+	{"focus", "focus", "focuses", "foci"},
+	{"formula", "formulas", "formulas", "formulae"},
+	{"forum", "forums", "forums", "fora"},
+	{"fungus", "fungus", "funguses", "fungi"},
+	{"genus", "genus", "genera", "genera"},
+	{"hippopotamus", "hippopotamus", "hippopotamuses", "hippopotami"},
+	{"index", "indices", "indexes", "indices"},
+	{"larva", "larvas", "larvas", "larvae"},
+	{"lemma", "lemmas", "lemmas", "lemmata"},
+	{"matrix", "matrices", "matrixes", "matrices"},
+	{"medium", "media", "media", "media"},
+	{"memorandum", "memorandums", "memorandums", "memoranda"},
+	{"millennium", "millennia", "millenniums", "millennia"},
+	{"nebula", "nebulas", "nebulas", "nebulae"},
+	{"nucleus", "nucleus", "nucleuses", "nuclei"},
+	{"octopus", "octopi", "octopuses", "octopodes"},
+	{"phenomenon", "phenomenons", "phenomenons", "phenomena"},
+	{"platypus", "platypus", "platypuses", "platypuses"},
+	{"radius", "radius", "radiuses", "radii"},
+	{"referendum", "referendums", "referendums", "referenda"},
+	{"schema", "schemas", "schemas", "schemata"},
+	{"seraph", "seraphs", "seraphs", "seraphim"},
+	{"stadium", "stadia", "stadiums", "stadia"},
+	{"stigma", "stigmas", "stigmas", "stigmata"},
+	{"stimulus", "stimulus", "stimuli", "stimuli"},
+	{"syllabus", "syllabuses", "syllabuses", "syllabi"},
+	{"testis", "testes", "testes", "testes"},
+	{"vertebra", "vertebras", "vertebras", "vertebrae"},
+	{"vertex", "vertices", "vertexes", "vertices"},
+	{"virus", "viri", "viruses", "viruses"},
+	{"vortex", "vortexes", "vortexes", "vortices"},
+}
+
+// AddStyle of pluralization to the passed rules.
+// adding a style replaces any style added before it; RailsStyle returns to the plurals of AddDefaultRules.
+// returns ErrUnknownStyle, and leaves the rules as they were, if the style is unknown.
+func AddStyle(rs *Ruleset, style Style) (err error) {
+	if style < RailsStyle || style > ClassicalStyle {
+		err = ErrUnknownStyle
+	} else {
+		rs.removeStyles()
+		for _, row := range stylePlurals {
+			// the rails plurals come from the default rules
+			if plural := row[1+int(style)]; plural != row[1] {
+				rs.AddIrregular(row[0], plural)
+			}
+		}
+	}
+	return
+}
+
+// remove the irregulars which AddStyle added.
+func (rs *Ruleset) removeStyles() {
+	plurals, singulars := make(map[Rule]bool), make(map[Rule]bool)
+	for _, row := range stylePlurals {
+		for _, plural := range row[2:] {
+			if plural != row[1] {
+				plurals[Rule{match: row[0], sub: plural}] = true
+				plurals[Rule{match: plural, sub: plural}] = true
+				singulars[Rule{match: plural, sub: row[0]}] = true
+			}
+		}
+	}
+	rs.plurals = removeRules(rs.plurals, plurals)
+	rs.singulars = removeRules(rs.singulars, singulars)
+}
+
+func removeRules(rules []Rule, remove map[Rule]bool) (ret []Rule) {
+	for _, rule := range rules {
+		if !remove[rule] {
+			ret = append(ret, rule)
+		}
+	}
+	return
+}
@@ -0,0 +1,130 @@
+package inflect
+
+import (
+	"testing"
+)
+
+var StyleToPlurals = map[Style]map[string]string{
+	RailsStyle: {
+		"index":   "indices",
+		"octopus": "octopi",
+		"virus":   "viri",
+		"schema":  "schemas",
+		"formula": "formulas",
+		"stadium": "stadia",
+	},
+	ModernStyle: {
+		"index":    "indexes",
+		"octopus":  "octopuses",
+		"virus":    "viruses",
+		"schema":   "schemas",
+		"formula":  "formulas",
+		"stadium":  "stadiums",
+		"appendix": "appendixes",
+		"cactus":   "cactuses",
+		"matrix":   "matrixes",
+		"vertex":   "vertexes",
+		"ApiIndex": "ApiIndexes",
+	},
+	ClassicalStyle: {
+		"index":      "indices",
+		"octopus":    "octopodes",
+		"virus":      "viruses",
+		"schema":     "schemata",
+		"formula":    "formulae",
+		"stadium":    "stadia",
+		"appendix":   "appendices",
+		"cactus":     "cacti",
+		"criterion":  "criteria",
+		"phenomenon": "phenomena",
+		"vertebra":   "vertebrae",
+		"ApiSchema":  "ApiSchemata",
+	},
+}
+
+func TestStyles(t *testing.T) {
+	for style, plurals := range StyleToPlurals {
+		rs := AddDefaultRules(&Ruleset{})
+		if e := AddStyle(rs, style); e != nil {
+			t.Fatal(e)
+		}
+		for singular, plural := range plurals {
+			if want, got := plural, rs.Pluralize(singular); got != want {
+				t.Error(style, "want", want, "got", got)
+			}
+			if want, got := plural, rs.Pluralize(plural); got != want {
+				t.Error(style, "want", want, "got", got)
+			}
+			if want, got := singular, rs.Singularize(plural); got != want {
+				t.Error(style, "want", want, "got", got)
+			}
+		}
+	}
+}
+
+func TestSwitchStyles(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	AddStyle(rs, ClassicalStyle)
+	AddStyle(rs, ModernStyle)
+	if want, got := "indexes", rs.Pluralize("index"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	AddStyle(rs, RailsStyle)
+	if want, got := "indices", rs.Pluralize("index"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	// old plurals can still be singularized
+	if want, got := "index", rs.Singularize("indexes"); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
+
+func TestStylesReplaceEachOther(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	AddStyle(rs, ClassicalStyle)
+	AddStyle(rs, ModernStyle)
+	for singular, plural := range map[string]string{
+		"criterion": "criterions",
+		"nucleus":   "nucleuses",
+		"radius":    "radiuses",
+		"matrix":    "matrixes",
+		"alumnus":   "alumni",
+	} {
+		if want, got := plural, rs.Pluralize(singular); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+	// switching back to rails undoes every other style
+	AddStyle(rs, RailsStyle)
+	defaults := AddDefaultRules(&Ruleset{})
+	for _, row := range stylePlurals {
+		if want, got := defaults.Pluralize(row[0]), rs.Pluralize(row[0]); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+func TestUnknownStyle(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	if e := AddStyle(rs, Style(-1)); e != ErrUnknownStyle {
+		t.Error("want", ErrUnknownStyle, "got", e)
+	}
+	if want, got := "indices", rs.Pluralize("index"); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
+
+// the rails style leaves the default rules alone rather than adding irregulars.
+func TestRailsStyleAddsNothing(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	plurals, singulars := len(rs.plurals), len(rs.singulars)
+	AddStyle(rs, RailsStyle)
+	if len(rs.plurals) != plurals || len(rs.singulars) != singulars {
+		t.Error("rails style changed the rules")
+	}
+	AddStyle(rs, ClassicalStyle)
+	AddStyle(rs, RailsStyle)
+	if len(rs.plurals) != plurals || len(rs.singulars) != singulars {
+		t.Error("rails style didn't remove the classical rules")
+	}
+}
@@ -0,0 +1,162 @@
+package inflect
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Apostrophes picks which letters, numbers, symbols, and abbreviations use "'s" for their plurals.
+type Apostrophes int
+
+const (
+	// NoApostrophes: "As", "ps", "1990s", "CDs", "Ph.D.s", "#s"
+	NoApostrophes Apostrophes = iota
+	// LetterApostrophes for single letters and symbols: "A's", "p's", "1990s", "CDs", "Ph.D.s", "#'s"
+	LetterApostrophes
+	// AllApostrophes: "A's", "p's", "1990's", "CD's", "Ph.D.'s", "#'s"
+	AllApostrophes
+)
+
+type termKind int
+
+const (
+	notTerm termKind = iota
+	letterTerm
+	numeralTerm
+	abbreviationTerm
+	symbolTerm
+	dottedTerm // file names and lowercase abbreviations: "file.txt", "e.g."
+)
+
+// SetApostrophes changes how Pluralize handles letters, numbers, symbols, and abbreviations.
+// Singularize understands every style.
+func (rs *Ruleset) SetApostrophes(style Apostrophes) {
+	rs.apostrophes = style
+}
+
+// letters, numbers, symbols, and abbreviations add "s" or "'s" rather than following the usual rules;
+// so "X" -> "Xs" rather than "Xes". file names, and terms which are already plural, stay as they are.
+func (rs *Ruleset) pluralizeTerm(word string) (ret string, okay bool) {
+	if kind := rs.classifyTerm(word); !rs.isUncountable(word) && (kind != notTerm || rs.isPluralTerm(word)) {
+		if kind == dottedTerm || rs.isPluralTerm(word) {
+			ret = word
+		} else if kind == letterTerm || kind == symbolTerm {
+			ret = word + rs.termSuffix(rs.apostrophes >= LetterApostrophes)
+		} else {
+			ret = word + rs.termSuffix(rs.apostrophes >= AllApostrophes)
+		}
+		okay = true
+	}
+	return
+}
+
+func (rs *Ruleset) termSuffix(apostrophe bool) (ret string) {
+	if apostrophe {
+		ret = "'s"
+	} else {
+		ret = "s"
+	}
+	return
+}
+
+// terms which already end in their plural: "Ph.D.s", "Ss", "U.S."
+func (rs *Ruleset) isPluralTerm(word string) bool {
+	stem, ok := rs.singularizeTerm(word)
+	return (ok && stem != word) || strings.HasSuffix(word, ".S.")
+}
+
+// the reverse of pluralizeTerm: "A's" -> "A", "CDs" -> "CD", "1990s" -> "1990"
+func (rs *Ruleset) singularizeTerm(word string) (ret string, okay bool) {
+	if !rs.isUncountable(word) {
+		for _, suffix := range []string{"'s", "s"} {
+			if stem := strings.TrimSuffix(word, suffix); len(stem) < len(word) && rs.classifyTerm(stem) != notTerm {
+				ret, okay = stem, true
+				break
+			}
+		}
+		if !okay && rs.classifyTerm(word) != notTerm {
+			ret, okay = word, true // already singular: "OS" isn't the plural of "O".
+		}
+	}
+	return
+}
+
+// an uppercase word is only an abbreviation when it isn't a word the ruleset knows:
+// "CD" -> "CDs", but "OX" -> "OXEN" and "USERS" -> "USER".
+func (rs *Ruleset) classifyTerm(word string) (ret termKind) {
+	if ret = classifyTerm(word); ret == abbreviationTerm && !hasDotsOrDigits(word) {
+		if rs.isKnownWord(rs.toLower(word)) {
+			ret = notTerm
+		}
+	}
+	return
+}
+
+func hasDotsOrDigits(word string) bool {
+	return strings.ContainsAny(word, ".0123456789")
+}
+
+// true for irregulars, uncountables, and common nouns, in either their singular or plural forms.
+func (rs *Ruleset) isKnownWord(lower string) (okay bool) {
+	if _, exact := find(rs.plurals, lower); exact {
+		okay = true
+	} else if _, exact := find(rs.singulars, lower); exact {
+		okay = true
+	} else if rs.isNoun(lower) || rs.isUncountable(lower) {
+		okay = true
+	} else if single, _ := find(rs.singulars, lower); len(single) > 0 && rs.isNoun(single) {
+		okay = true
+	}
+	return
+}
+
+// single letters: "A", "p"; numbers: "1990", "1,000"; symbols: "#", "&";
+// and abbreviations: "CD", "MP3", "3D", "Ph.D."
+func classifyTerm(word string) (ret termKind) {
+	var letters, lower, digits, dots int
+	for _, c := range word {
+		switch {
+		case isSpacerChar(c) || c == utf8.RuneError:
+			return notTerm
+		case unicode.IsLetter(c):
+			letters++
+			if unicode.IsLower(c) {
+				lower++
+			}
+		case unicode.IsDigit(c):
+			digits++
+		case c == '.':
+			dots++
+		}
+	}
+	switch {
+	case len(word) == 0:
+		ret = notTerm
+	case letters == 0 && digits == 0:
+		ret = symbolTerm
+	case letters == 0:
+		ret = numeralTerm
+	case letters == 1 && utf8.RuneCountInString(word) == 1:
+		ret = letterTerm
+	case dots > 0 && lower < letters && isDottedAbbreviation(word):
+		ret = abbreviationTerm
+	case dots > 0:
+		ret = dottedTerm
+	case lower == 0 && (letters > 1 || digits > 0):
+		ret = abbreviationTerm // "CD", "3D"
+	}
+	return
+}
+
+// short pieces between the dots: "Ph.D.", "U.S.A."; but not "Readme.txt"
+func isDottedAbbreviation(word string) (okay bool) {
+	okay = true
+	for _, part := range strings.Split(word, ".") {
+		if utf8.RuneCountInString(part) > 3 {
+			okay = false
+			break
+		}
+	}
+	return
+}
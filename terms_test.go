@@ -0,0 +1,116 @@
+package inflect
+
+import (
+	"reflect"
+	"testing"
+)
+
+var TermToPlural = map[Apostrophes]map[string]string{
+	NoApostrophes: {
+		"A":      "As",
+		"X":      "Xs",
+		"S":      "Ss",
+		"1990":   "1990s",
+		"747":    "747s",
+		"CD":     "CDs",
+		"MP3":    "MP3s",
+		"Ph.D.":  "Ph.D.s",
+		"#":      "#s",
+		"OS":     "OSs",
+		"ID":     "IDs",
+		"1,000":  "1,000s",
+		"person": "people",
+		"box":    "boxes",
+	},
+	LetterApostrophes: {
+		"A":     "A's",
+		"p":     "p's",
+		"s":     "s's",
+		"1990":  "1990s",
+		"CD":    "CDs",
+		"Ph.D.": "Ph.D.s",
+		"#":     "#'s",
+	},
+	AllApostrophes: {
+		"A":     "A's",
+		"p":     "p's",
+		"1990":  "1990's",
+		"CD":    "CD's",
+		"Ph.D.": "Ph.D.'s",
+		"#":     "#'s",
+	},
+}
+
+func TestPluralizeTerms(t *testing.T) {
+	for style, plurals := range TermToPlural {
+		rs := AddDefaultRules(&Ruleset{})
+		rs.SetApostrophes(style)
+		for singular, plural := range plurals {
+			if want, got := plural, rs.Pluralize(singular); got != want {
+				t.Error(style, "want", want, "got", got)
+			}
+			if want, got := singular, rs.Singularize(plural); got != want {
+				t.Error(style, "want", want, "got", got)
+			}
+			if want, got := singular, rs.Singularize(singular); got != want {
+				t.Error(style, "want", want, "got", got)
+			}
+		}
+	}
+}
+
+// uppercase words which the rules know aren't abbreviations.
+func TestUppercaseWords(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for singular, plural := range map[string]string{
+		"PERSON": "PEOPLE",
+		"OX":     "OXEN",
+		"BOX":    "BOXES",
+		"CHILD":  "CHILDREN",
+		"USER":   "USERS",
+		"SHEEP":  "SHEEP",
+	} {
+		if want, got := plural, rs.Pluralize(singular); got != want {
+			t.Error("want", want, "got", got)
+		}
+		if want, got := singular, rs.Singularize(plural); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+// words with dots which aren't abbreviations stay as they are.
+func TestDottedWords(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for _, word := range []string{"file.txt", "e.g.", "Readme.md"} {
+		if want, got := word, rs.Pluralize(word); got != want {
+			t.Error("want", want, "got", got)
+		}
+		if want, got := word, rs.Singularize(word); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+// terms with digits, and terms which are already plural.
+func TestTermEdges(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for word, plural := range map[string]string{
+		"3D":     "3Ds",
+		"3Ds":    "3Ds",
+		"Ph.D.s": "Ph.D.s",
+		"U.S.":   "U.S.",
+		"Ss":     "Ss",
+		"CDs":    "CDs",
+	} {
+		if want, got := plural, rs.Pluralize(word); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+	// Singularize returns the first of the candidates
+	for _, word := range []string{"OS", "OSs", "3Ds", "Ph.D.s", "A's", "CDs", "1990s"} {
+		if want, got := []string{rs.Singularize(word)}, rs.SingularCandidates(word); !reflect.DeepEqual(got, want) {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
@@ -0,0 +1,392 @@
+package inflect
+
+import "strings"
+
+// common verbs with irregular past tenses: base, past, past participle.
+var irregularVerbs = [][3]string{
+	{"arise", "arose", "arisen"},
+	{"awake", "awoke", "awoken"},
+	{"be", "was", "been"},
+	{"bear", "bore", "borne"},
+	{"beat", "beat", "beaten"},
+	{"become", "became", "become"},
+	{"begin", "began", "begun"},
+	{"bend", "bent", "bent"},
+	{"bet", "bet", "bet"},
+	{"bid", "bid", "bid"},
+	{"bind", "bound", "bound"},
+	{"bite", "bit", "bitten"},
+	{"bleed", "bled", "bled"},
+	{"blow", "blew", "blown"},
+	{"break", "broke", "broken"},
+	{"breed", "bred", "bred"},
+	{"bring", "brought", "brought"},
+	{"broadcast", "broadcast", "broadcast"},
+	{"build", "built", "built"},
+	{"burst", "burst", "burst"},
+	{"buy", "bought", "bought"},
+	{"cast", "cast", "cast"},
+	{"catch", "caught", "caught"},
+	{"choose", "chose", "chosen"},
+	{"cling", "clung", "clung"},
+	{"come", "came", "come"},
+	{"cost", "cost", "cost"},
+	{"creep", "crept", "crept"},
+	{"cut", "cut", "cut"},
+	{"deal", "dealt", "dealt"},
+	{"dig", "dug", "dug"},
+	{"do", "did", "done"},
+	{"draw", "drew", "drawn"},
+	{"drink", "drank", "drunk"},
+	{"drive", "drove", "driven"},
+	{"eat", "ate", "eaten"},
+	{"fall", "fell", "fallen"},
+	{"feed", "fed", "fed"},
+	{"feel", "felt", "felt"},
+	{"fight", "fought", "fought"},
+	{"find", "found", "found"},
+	{"flee", "fled", "fled"},
+	{"fling", "flung", "flung"},
+	{"fly", "flew", "flown"},
+	{"forbid", "forbade", "forbidden"},
+	{"forecast", "forecast", "forecast"},
+	{"forget", "forgot", "forgotten"},
+	{"forgive", "forgave", "forgiven"},
+	{"freeze", "froze", "frozen"},
+	{"get", "got", "gotten"},
+	{"give", "gave", "given"},
+	{"go", "went", "gone"},
+	{"grind", "ground", "ground"},
+	{"grow", "grew", "grown"},
+	{"hang", "hung", "hung"},
+	{"have", "had", "had"},
+	{"hear", "heard", "heard"},
+	{"hide", "hid", "hidden"},
+	{"hit", "hit", "hit"},
+	{"hold", "held", "held"},
+	{"hurt", "hurt", "hurt"},
+	{"keep", "kept", "kept"},
+	{"kneel", "knelt", "knelt"},
+	{"know", "knew", "known"},
+	{"lay", "laid", "laid"},
+	{"lead", "led", "led"},
+	{"leave", "left", "left"},
+	{"lend", "lent", "lent"},
+	{"let", "let", "let"},
+	{"light", "lit", "lit"},
+	{"lose", "lost", "lost"},
+	{"make", "made", "made"},
+	{"mean", "meant", "meant"},
+	{"meet", "met", "met"},
+	{"mislead", "misled", "misled"},
+	{"overcome", "overcame", "overcome"},
+	{"override", "overrode", "overridden"},
+	{"overwrite", "overwrote", "overwritten"},
+	{"pay", "paid", "paid"},
+	{"prove", "proved", "proven"},
+	{"put", "put", "put"},
+	{"quit", "quit", "quit"},
+	{"read", "read", "read"},
+	{"rebuild", "rebuilt", "rebuilt"},
+	{"redo", "redid", "redone"},
+	{"rerun", "reran", "rerun"},
+	{"reset", "reset", "reset"},
+	{"rewrite", "rewrote", "rewritten"},
+	{"ride", "rode", "ridden"},
+	{"ring", "rang", "rung"},
+	{"rise", "rose", "risen"},
+	{"run", "ran", "run"},
+	{"say", "said", "said"},
+	{"see", "saw", "seen"},
+	{"seek", "sought", "sought"},
+	{"sell", "sold", "sold"},
+	{"send", "sent", "sent"},
+	{"set", "set", "set"},
+	{"shake", "shook", "shaken"},
+	{"shed", "shed", "shed"},
+	{"shine", "shone", "shone"},
+	{"shoot", "shot", "shot"},
+	{"show", "showed", "shown"},
+	{"shrink", "shrank", "shrunk"},
+	{"shut", "shut", "shut"},
+	{"sing", "sang", "sung"},
+	{"sink", "sank", "sunk"},
+	{"sit", "sat", "sat"},
+	{"sleep", "slept", "slept"},
+	{"slide", "slid", "slid"},
+	{"speak", "spoke", "spoken"},
+	{"spend", "spent", "spent"},
+	{"spin", "spun", "spun"},
+	{"split", "split", "split"},
+	{"spread", "spread", "spread"},
+	{"spring", "sprang", "sprung"},
+	{"stand", "stood", "stood"},
+	{"steal", "stole", "stolen"},
+	{"stick", "stuck", "stuck"},
+	{"sting", "stung", "stung"},
+	{"strike", "struck", "struck"},
+	{"string", "strung", "strung"},
+	{"swear", "swore", "sworn"},
+	{"sweep", "swept", "swept"},
+	{"swim", "swam", "swum"},
+	{"swing", "swung", "swung"},
+	{"take", "took", "taken"},
+	{"teach", "taught", "taught"},
+	{"tear", "tore", "torn"},
+	{"tell", "told", "told"},
+	{"think", "thought", "thought"},
+	{"throw", "threw", "thrown"},
+	{"understand", "understood", "understood"},
+	{"undo", "undid", "undone"},
+	{"upset", "upset", "upset"},
+	{"wake", "woke", "woken"},
+	{"wear", "wore", "worn"},
+	{"win", "won", "won"},
+	{"wind", "wound", "wound"},
+	{"withdraw", "withdrew", "withdrawn"},
+	{"write", "wrote", "written"},
+}
+
+// verbs of more than one syllable which double their final consonant: "commit" -> "committed".
+// ( one syllable verbs like "stop" double automatically. )
+var doubledVerbs = []string{
+	"abet", "acquit", "admit", "allot", "begin", "commit", "compel", "concur", "confer", "control",
+	"defer", "deter", "embed", "emit", "equip", "expel", "forbid", "forget", "format",
+	"incur", "infer", "kidnap", "occur", "omit", "outwit", "patrol", "permit", "prefer",
+	"program", "propel", "rebel", "recur", "refer", "regret", "repel", "reset", "submit",
+	"transfer", "transmit", "unzip", "upset",
+}
+
+func addDefaultVerbs(rs *Ruleset) {
+	for _, verb := range doubledVerbs {
+		last := verb[len(verb)-1:]
+		rs.AddPastTense(verb, verb+last+"ed")
+		rs.AddPresentParticiple(verb, verb+last+"ing")
+	}
+	// exceptions to the doubled verbs
+	rs.AddPastTense("vomit", "vomited")
+	rs.AddPresentParticiple("vomit", "vomiting")
+	// a "k" keeps the "c" hard: "panic" -> "panicked"
+	rs.AddPastTense("ic", "icked")
+	rs.AddPresentParticiple("ic", "icking")
+	// "undo" -> "undoes", "forgo" -> "forgoes"
+	rs.AddThirdPerson("do", "does")
+	rs.AddThirdPerson("go", "goes")
+	// most verbs ending in "o" take "s": "demo" -> "demos", "solo" -> "solos"; these take "es".
+	for _, verb := range []string{"echo", "embargo", "torpedo", "veto"} {
+		rs.AddThirdPerson(verb, verb+"es")
+	}
+	rs.AddThirdPerson("quiz", "quizzes")
+	rs.AddThirdPersonExact("be", "is", true)
+	rs.AddThirdPersonExact("have", "has", true)
+	rs.AddPresentParticipleExact("be", "being", true)
+	for _, v := range irregularVerbs {
+		rs.AddIrregularVerb(v[0], v[1], v[2])
+	}
+}
+
+// add a rule for the third person singular present: "go" -> "goes"
+func (rs *Ruleset) AddThirdPerson(suffix, replacement string) {
+	rs.AddThirdPersonExact(suffix, replacement, false)
+}
+
+// same as AddThirdPerson but you can set `exact` to force a full word match: "be" -> "is"
+func (rs *Ruleset) AddThirdPersonExact(suffix, replacement string, exact bool) {
+	rs.thirdPersons = append(rs.thirdPersons, Rule{match: suffix, sub: replacement, exact: exact})
+}
+
+// add a rule for the past tense: "commit" -> "committed"
+func (rs *Ruleset) AddPastTense(suffix, replacement string) {
+	rs.pastTenses = append(rs.pastTenses, Rule{match: suffix, sub: replacement})
+}
+
+// add a rule for the past participle: "write" -> "written".
+// verbs without a past participle rule use their past tense.
+func (rs *Ruleset) AddPastParticiple(suffix, replacement string) {
+	rs.pastParticiples = append(rs.pastParticiples, Rule{match: suffix, sub: replacement})
+}
+
+// add a rule for the present participle: "commit" -> "committing"
+func (rs *Ruleset) AddPresentParticiple(suffix, replacement string) {
+	rs.AddPresentParticipleExact(suffix, replacement, false)
+}
+
+// same as AddPresentParticiple but you can set `exact` to force a full word match: "be" -> "being"
+func (rs *Ruleset) AddPresentParticipleExact(suffix, replacement string, exact bool) {
+	rs.presentParticiples = append(rs.presentParticiples, Rule{match: suffix, sub: replacement, exact: exact})
+}
+
+// Add a verb whose past forms don't follow the usual rules: "write", "wrote", "written".
+// unlike the suffix rules, irregular verbs match the whole verb; so "come" doesn't change "welcome".
+func (rs *Ruleset) AddIrregularVerb(base, past, participle string) {
+	rs.pastTenses = append(rs.pastTenses, Rule{match: base, sub: past, exact: true})
+	rs.pastParticiples = append(rs.pastParticiples, Rule{match: base, sub: participle, exact: true})
+}
+
+// ThirdPerson: the present tense used with he, she, it, or a singular noun: "delete" -> "deletes", "go" -> "goes".
+// phrasal verbs change their first word: "log in" -> "logs in".
+func (rs *Ruleset) ThirdPerson(verb string) string {
+	return rs.conjugate(rs.thirdPersons, verb, thirdPersonRegular)
+}
+
+// PastTense: "delete" -> "deleted", "stop" -> "stopped", "write" -> "wrote"
+func (rs *Ruleset) PastTense(verb string) string {
+	return rs.conjugate(rs.pastTenses, verb, pastRegular)
+}
+
+// PastParticiple: "delete" -> "deleted", "write" -> "written"
+func (rs *Ruleset) PastParticiple(verb string) string {
+	return rs.conjugate(rs.pastParticiples, verb, func(lower string) string {
+		return rs.PastTense(lower)
+	})
+}
+
+// PresentParticiple, or gerund: "delete" -> "deleting", "stop" -> "stopping", "tie" -> "tying"
+func (rs *Ruleset) PresentParticiple(verb string) string {
+	return rs.conjugate(rs.presentParticiples, verb, presentParticipleRegular)
+}
+
+// apply the passed rules to the first word of verb, falling back to the regular form.
+func (rs *Ruleset) conjugate(rules []Rule, verb string, regular func(lower string) string) (ret string) {
+	head, tail := verb, ""
+	if i := strings.IndexByte(verb, ' '); i > 0 {
+		head, tail = verb[:i], verb[i:]
+	}
+	if lower := rs.toLower(head); len(lower) == 0 {
+		ret = verb
+	} else {
+		var sub string
+		if rule, ok := findRule(rules, lower); ok {
+			sub = strings.TrimSuffix(lower, rule.match) + rule.sub
+		} else {
+			sub = regular(lower)
+		}
+		ret = rs.matchCase(head, sub) + tail
+	}
+	return
+}
+
+func thirdPersonRegular(verb string) (ret string) {
+	for _, end := range sibilantEndings {
+		if strings.HasSuffix(verb, end) {
+			return verb + "es"
+		}
+	}
+	if endsInConsonantY(verb) {
+		ret = verb[:len(verb)-1] + "ies"
+	} else {
+		ret = verb + "s"
+	}
+	return
+}
+
+func pastRegular(verb string) (ret string) {
+	if strings.HasSuffix(verb, "e") {
+		ret = verb + "d"
+	} else if endsInConsonantY(verb) {
+		ret = verb[:len(verb)-1] + "ied"
+	} else if doublesFinal(verb) {
+		ret = verb + verb[len(verb)-1:] + "ed"
+	} else {
+		ret = verb + "ed"
+	}
+	return
+}
+
+func presentParticipleRegular(verb string) (ret string) {
+	if strings.HasSuffix(verb, "ie") {
+		ret = verb[:len(verb)-2] + "ying"
+	} else if strings.HasSuffix(verb, "e") && !strings.HasSuffix(verb, "ee") &&
+		!strings.HasSuffix(verb, "ye") && !strings.HasSuffix(verb, "oe") {
+		ret = verb[:len(verb)-1] + "ing" // silent e: "make" -> "making"; but "see" -> "seeing"
+	} else if doublesFinal(verb) {
+		ret = verb + verb[len(verb)-1:] + "ing"
+	} else {
+		ret = verb + "ing"
+	}
+	return
+}
+
+func isVowel(c byte) bool {
+	return strings.IndexByte("aeiou", c) >= 0
+}
+
+// "try" but not "play"
+func endsInConsonantY(word string) bool {
+	n := len(word)
+	return n > 1 && word[n-1] == 'y' && !isVowel(word[n-2])
+}
+
+// one syllable words ending in a consonant, vowel, consonant double the final consonant: "stop" -> "stopped".
+// the "u" of "qu" acts as a consonant: "quit" -> "quitting".
+func doublesFinal(word string) (ret bool) {
+	if n := len(word); n >= 3 {
+		last, vowel, before := word[n-1], word[n-2], word[n-3]
+		consonantBefore := !isVowel(before) || (before == 'u' && n >= 4 && word[n-4] == 'q')
+		ret = isLowerLetter(last) && !isVowel(last) && strings.IndexByte("wxy", last) < 0 &&
+			isVowel(vowel) && consonantBefore && isLowerLetter(before) && syllables(word) == 1
+	}
+	return
+}
+
+func isLowerLetter(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
+
+// a rough count of the vowel groups in a word; "qu" doesn't count.
+func syllables(word string) (ret int) {
+	inVowel := false
+	for i := 0; i < len(word); i++ {
+		c := word[i]
+		v := isVowel(c) && !(c == 'u' && i > 0 && word[i-1] == 'q')
+		if v && !inVowel {
+			ret++
+		}
+		inVowel = v
+	}
+	return
+}
+
+func AddThirdPerson(suffix, replacement string) {
+	Rules.AddThirdPerson(suffix, replacement)
+}
+
+func AddThirdPersonExact(suffix, replacement string, exact bool) {
+	Rules.AddThirdPersonExact(suffix, replacement, exact)
+}
+
+func AddPastTense(suffix, replacement string) {
+	Rules.AddPastTense(suffix, replacement)
+}
+
+func AddPastParticiple(suffix, replacement string) {
+	Rules.AddPastParticiple(suffix, replacement)
+}
+
+func AddPresentParticiple(suffix, replacement string) {
+	Rules.AddPresentParticiple(suffix, replacement)
+}
+
+func AddPresentParticipleExact(suffix, replacement string, exact bool) {
+	Rules.AddPresentParticipleExact(suffix, replacement, exact)
+}
+
+func AddIrregularVerb(base, past, participle string) {
+	Rules.AddIrregularVerb(base, past, participle)
+}
+
+func ThirdPerson(verb string) string {
+	return Rules.ThirdPerson(verb)
+}
+
+func PastTense(verb string) string {
+	return Rules.PastTense(verb)
+}
+
+func PastParticiple(verb string) string {
+	return Rules.PastParticiple(verb)
+}
+
+func PresentParticiple(verb string) string {
+	return Rules.PresentParticiple(verb)
+}
@@ -0,0 +1,109 @@
+package inflect
+
+import (
+	"testing"
+)
+
+// base, third person, past, past participle, present participle
+var VerbForms = [][5]string{
+	{"delete", "deletes", "deleted", "deleted", "deleting"},
+	{"create", "creates", "created", "created", "creating"},
+	{"update", "updates", "updated", "updated", "updating"},
+	{"stop", "stops", "stopped", "stopped", "stopping"},
+	{"plan", "plans", "planned", "planned", "planning"},
+	{"quit", "quits", "quit", "quit", "quitting"},
+	{"visit", "visits", "visited", "visited", "visiting"},
+	{"open", "opens", "opened", "opened", "opening"},
+	{"commit", "commits", "committed", "committed", "committing"},
+	{"prefer", "prefers", "preferred", "preferred", "preferring"},
+	{"offer", "offers", "offered", "offered", "offering"},
+	{"vomit", "vomits", "vomited", "vomited", "vomiting"},
+	{"try", "tries", "tried", "tried", "trying"},
+	{"play", "plays", "played", "played", "playing"},
+	{"tie", "ties", "tied", "tied", "tying"},
+	{"die", "dies", "died", "died", "dying"},
+	{"see", "sees", "saw", "seen", "seeing"},
+	{"agree", "agrees", "agreed", "agreed", "agreeing"},
+	{"dye", "dyes", "dyed", "dyed", "dyeing"},
+	{"fix", "fixes", "fixed", "fixed", "fixing"},
+	{"push", "pushes", "pushed", "pushed", "pushing"},
+	{"watch", "watches", "watched", "watched", "watching"},
+	{"quiz", "quizzes", "quizzed", "quizzed", "quizzing"},
+	{"panic", "panics", "panicked", "panicked", "panicking"},
+	{"go", "goes", "went", "gone", "going"},
+	{"undo", "undoes", "undid", "undone", "undoing"},
+	{"echo", "echoes", "echoed", "echoed", "echoing"},
+	{"veto", "vetoes", "vetoed", "vetoed", "vetoing"},
+	{"torpedo", "torpedoes", "torpedoed", "torpedoed", "torpedoing"},
+	{"demo", "demos", "demoed", "demoed", "demoing"},
+	{"be", "is", "was", "been", "being"},
+	{"have", "has", "had", "had", "having"},
+	{"write", "writes", "wrote", "written", "writing"},
+	{"begin", "begins", "began", "begun", "beginning"},
+	{"forget", "forgets", "forgot", "forgotten", "forgetting"},
+	{"come", "comes", "came", "come", "coming"},
+	{"welcome", "welcomes", "welcomed", "welcomed", "welcoming"},
+	{"bring", "brings", "brought", "brought", "bringing"},
+	{"Delete", "Deletes", "Deleted", "Deleted", "Deleting"},
+	{"STOP", "STOPS", "STOPPED", "STOPPED", "STOPPING"},
+	{"log in", "logs in", "logged in", "logged in", "logging in"},
+	{"set up", "sets up", "set up", "set up", "setting up"},
+	{"", "", "", "", ""},
+}
+
+func TestVerbs(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	for _, v := range VerbForms {
+		if want, got := v[1], rs.ThirdPerson(v[0]); got != want {
+			t.Error("want", want, "got", got)
+		}
+		if want, got := v[2], rs.PastTense(v[0]); got != want {
+			t.Error("want", want, "got", got)
+		}
+		if want, got := v[3], rs.PastParticiple(v[0]); got != want {
+			t.Error("want", want, "got", got)
+		}
+		if want, got := v[4], rs.PresentParticiple(v[0]); got != want {
+			t.Error("want", want, "got", got)
+		}
+	}
+}
+
+func TestAddIrregularVerb(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	rs.AddIrregularVerb("dive", "dove", "dived")
+	if want, got := "dove", rs.PastTense("dive"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	if want, got := "dived", rs.PastParticiple("dive"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	// irregular verbs match the whole word
+	if want, got := "skydived", rs.PastTense("skydive"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	// british spelling doubles the final "l"
+	rs.AddPastTense("travel", "travelled")
+	rs.AddPresentParticiple("travel", "travelling")
+	if want, got := "travelled", rs.PastParticiple("travel"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	if want, got := "travelling", rs.PresentParticiple("travel"); got != want {
+		t.Error("want", want, "got", got)
+	}
+}
+
+func TestAddThirdPersonExact(t *testing.T) {
+	rs := AddDefaultRules(&Ruleset{})
+	rs.AddThirdPersonExact("can", "can", true)
+	if want, got := "can", rs.ThirdPerson("can"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	// exact rules match the whole word
+	if want, got := "scans", rs.ThirdPerson("scan"); got != want {
+		t.Error("want", want, "got", got)
+	}
+	if want, got := "behaves", rs.ThirdPerson("behave"); got != want {
+		t.Error("want", want, "got", got)
+	}
+}